@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"os"
 
@@ -72,7 +73,12 @@ func main() {
 		},
 	}
 
-	if err := disgoform.Sync(bot); err != nil {
+	result, err := disgoform.Sync(bot)
+	if err != nil {
 		log.Printf("can't synchronize application commands with Discord: %v", err)
+
+		return
 	}
+
+	fmt.Print(result)
 }
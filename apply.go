@@ -0,0 +1,388 @@
+package disgoform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"time"
+
+	"github.com/switchupcb/disgo"
+)
+
+var (
+	// ErrStalePlan represents an error that occurs when the current application command state
+	// changes after a plan is computed.
+	ErrStalePlan = errors.New("application command state changed since the plan was computed")
+)
+
+// Apply executes the operations of a plan.
+//
+// Apply does not execute any operation when the current application command state
+// differs from the state the plan was computed from, or when the DryRun option is used.
+func Apply(bot *disgo.Client, plan *ChangePlan, opts ...Option) (*Result, error) {
+	return defaultSyncer(bot).ApplyContext(context.Background(), plan, opts...)
+}
+
+// ApplyContext executes the operations of a plan using a context.
+//
+// ApplyContext stops executing operations when the context is canceled
+// after the request in progress (including its rate limit wait),
+// and returns a Result containing the operations executed prior to the cancellation.
+func ApplyContext(ctx context.Context, bot *disgo.Client, plan *ChangePlan, opts ...Option) (*Result, error) {
+	return defaultSyncer(bot).ApplyContext(ctx, plan, opts...)
+}
+
+// Apply executes the operations of a plan.
+//
+// Apply does not execute any operation when the current application command state
+// differs from the state the plan was computed from, or when the DryRun option is used.
+func (s *Syncer) Apply(plan *ChangePlan, opts ...Option) (*Result, error) {
+	return s.ApplyContext(context.Background(), plan, opts...)
+}
+
+// ApplyContext executes the operations of a plan using a context.
+//
+// ApplyContext stops executing operations when the context is canceled
+// after the request in progress (including its rate limit wait),
+// and returns a Result containing the operations executed prior to the cancellation.
+func (s *Syncer) ApplyContext(ctx context.Context, plan *ChangePlan, opts ...Option) (*Result, error) {
+	c, err := s.config(opts)
+	if err != nil {
+		return nil, fmt.Errorf("Apply: %w", err)
+	}
+
+	if plan == nil {
+		return nil, errors.New("Apply: cannot apply nil plan")
+	}
+
+	// confirm the bot's current Application Command State matches the planned state.
+	for _, state := range plan.States {
+		currentCommands, err := getApplicationCommands(ctx, c.Client, state.Scope, state.GuildID)
+		if err != nil {
+			return nil, fmt.Errorf("Apply: %w", err)
+		}
+
+		if !maps.Equal(newState(state.Scope, state.GuildID, currentCommands).Versions, state.Versions) {
+			if state.Scope == ScopeGuild {
+				return nil, fmt.Errorf("Apply: guild %q: %w", state.GuildID, ErrStalePlan)
+			}
+
+			return nil, fmt.Errorf("Apply: %s: %w", state.Scope, ErrStalePlan)
+		}
+	}
+
+	if err := checkDeletions(c, plan); err != nil {
+		return nil, fmt.Errorf("Apply: %w", err)
+	}
+
+	if c.DryRun {
+		return newResult(plan, planned(plan), true), nil
+	}
+
+	applied, err := apply(ctx, c, plan)
+	if err != nil {
+		return newResult(plan, applied, false), fmt.Errorf("Apply: %w", err)
+	}
+
+	return newResult(plan, applied, false), nil
+}
+
+// execution represents an executed operation.
+type execution struct {
+	operation *Operation
+
+	// command represents the application command returned by Discord (nil when deleted or planned).
+	command *disgo.ApplicationCommand
+}
+
+// apply executes the operations of a plan using a configuration
+// and returns the operations which are executed.
+//
+// An operation which fails is returned as an *OperationError, or an *AggregateError
+// when the ContinueOnError option is used.
+func apply(ctx context.Context, c *Config, plan *ChangePlan) ([]execution, error) {
+	var (
+		applied []execution
+		err     error
+	)
+
+	if c.Strategy == StrategyBulk {
+		applied, err = applyBulk(ctx, c, plan)
+	} else {
+		applied, err = applyOperations(ctx, c, plan)
+	}
+
+	// record the executed operations, including those which succeeded prior to a failure.
+	if stateErr := recordState(c, applied); stateErr != nil {
+		return applied, errors.Join(err, stateErr)
+	}
+
+	return applied, err
+}
+
+// applyOperations executes the operations of a plan using one request for each changed application command.
+func applyOperations(ctx context.Context, c *Config, plan *ChangePlan) ([]execution, error) {
+	applied := make([]execution, 0, len(plan.Operations))
+
+	var errs []*OperationError
+
+	for _, operation := range plan.Operations {
+		if err := ctx.Err(); err != nil {
+			return applied, errors.Join(err, newAggregateError(errs))
+		}
+
+		var (
+			command *disgo.ApplicationCommand
+			err     error
+		)
+
+		start := time.Now()
+
+		switch operation.Scope {
+		case ScopeGlobal:
+			command, err = applyGlobalOperation(c, operation)
+		case ScopeGuild:
+			command, err = applyGuildOperation(c, operation)
+		default:
+			err = fmt.Errorf("unknown scope %q", operation.Scope)
+		}
+
+		if operation.Action.changes() {
+			logOperation(c, operation, command, time.Since(start), err)
+		}
+
+		if err != nil {
+			if !c.ContinueOnError {
+				return applied, newOperationError(operation, err)
+			}
+
+			errs = append(errs, newOperationError(operation, err))
+
+			continue
+		}
+
+		applied = append(applied, execution{operation: operation, command: command})
+	}
+
+	return applied, newAggregateError(errs)
+}
+
+// applyGlobalOperation executes an operation on a global application command.
+func applyGlobalOperation(c *Config, operation *Operation) (*disgo.ApplicationCommand, error) {
+	var (
+		command *disgo.ApplicationCommand
+		err     error
+	)
+
+	switch operation.Action {
+	case ActionCreate:
+		request := &createGlobalApplicationCommand{CreateGlobalApplicationCommand: *operation.Global, Handler: operation.Handler}
+
+		command, err = request.Send(c.Client)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create defined application command %v: %w", operation.Key(), err)
+		}
+
+	case ActionUpdate:
+		request := newEditGlobalApplicationCommand(operation.CommandID, *operation.Global)
+		request.Handler = operation.Handler
+
+		command, err = request.Send(c.Client)
+		if err != nil {
+			return nil, fmt.Errorf("cannot update current application command %v: %w", operation.Key(), err)
+		}
+
+	case ActionDelete:
+		request := &disgo.DeleteGlobalApplicationCommand{
+			CommandID: operation.CommandID,
+		}
+
+		if err := request.Send(c.Client); err != nil {
+			return nil, fmt.Errorf("cannot delete current application command %v: %w", operation.Key(), err)
+		}
+
+	case ActionRecreate:
+		request := &disgo.DeleteGlobalApplicationCommand{
+			CommandID: operation.CommandID,
+		}
+
+		if err := request.Send(c.Client); err != nil {
+			return nil, fmt.Errorf("cannot delete recreated application command %v: %w", operation.Key(), err)
+		}
+
+		create := &createGlobalApplicationCommand{CreateGlobalApplicationCommand: *operation.Global, Handler: operation.Handler}
+
+		command, err = create.Send(c.Client)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create recreated application command %v: %w", operation.Key(), err)
+		}
+
+	case ActionNoOp, ActionUnmanaged:
+	}
+
+	return command, nil
+}
+
+// applyGuildOperation executes an operation on a guild application command.
+func applyGuildOperation(c *Config, operation *Operation) (*disgo.ApplicationCommand, error) {
+	var (
+		command *disgo.ApplicationCommand
+		err     error
+	)
+
+	switch operation.Action {
+	case ActionCreate:
+		command, err = operation.Guild.Send(c.Client)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create defined guild %q application command %v: %w", operation.GuildID, operation.Key(), err)
+		}
+
+	case ActionUpdate:
+		request := newEditGuildApplicationCommand(operation.CommandID, *operation.Guild)
+
+		command, err = request.Send(c.Client)
+		if err != nil {
+			return nil, fmt.Errorf("cannot update current guild %q application command %v: %w", operation.GuildID, operation.Key(), err)
+		}
+
+	case ActionDelete:
+		request := &disgo.DeleteGuildApplicationCommand{
+			GuildID:   operation.GuildID,
+			CommandID: operation.CommandID,
+		}
+
+		if err := request.Send(c.Client); err != nil {
+			return nil, fmt.Errorf("cannot delete current guild %q application command %v: %w", operation.GuildID, operation.Key(), err)
+		}
+
+	case ActionRecreate:
+		request := &disgo.DeleteGuildApplicationCommand{
+			GuildID:   operation.GuildID,
+			CommandID: operation.CommandID,
+		}
+
+		if err := request.Send(c.Client); err != nil {
+			return nil, fmt.Errorf("cannot delete recreated guild %q application command %v: %w", operation.GuildID, operation.Key(), err)
+		}
+
+		command, err = operation.Guild.Send(c.Client)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create recreated guild %q application command %v: %w", operation.GuildID, operation.Key(), err)
+		}
+
+	case ActionNoOp, ActionUnmanaged:
+	}
+
+	return command, nil
+}
+
+// applyBulk executes the operations of a plan using one bulk overwrite request for each changed scope.
+func applyBulk(ctx context.Context, c *Config, plan *ChangePlan) ([]execution, error) {
+	applied := make([]execution, 0, len(plan.Operations))
+
+	var errs []*OperationError
+
+	for _, state := range plan.States {
+		if err := ctx.Err(); err != nil {
+			return applied, errors.Join(err, newAggregateError(errs))
+		}
+
+		request := &bulkOverwriteApplicationCommands{
+			GuildID:             state.GuildID,
+			ApplicationCommands: nil,
+		}
+
+		changed := false
+
+		var operations []*Operation
+
+		for _, operation := range plan.Operations {
+			if operation.Scope != state.Scope || operation.GuildID != state.GuildID {
+				continue
+			}
+
+			operations = append(operations, operation)
+
+			if operation.Action.changes() {
+				changed = true
+			}
+
+			// the ID of a recreated application command is omitted to create a new application command.
+			commandID := operation.CommandID
+			if operation.Action == ActionCreate || operation.Action == ActionRecreate {
+				commandID = ""
+			}
+
+			switch {
+			case operation.Action == ActionDelete:
+			case operation.Global != nil:
+				command := newBulkGlobalApplicationCommand(commandID, *operation.Global)
+				command.Handler = operation.Handler
+				request.ApplicationCommands = append(request.ApplicationCommands, command)
+			case operation.Guild != nil:
+				request.ApplicationCommands = append(request.ApplicationCommands, newBulkGuildApplicationCommand(commandID, *operation.Guild))
+			}
+		}
+
+		if !changed {
+			continue
+		}
+
+		start := time.Now()
+
+		commands, err := request.Send(c.Client)
+
+		attrs := []any{slog.String(logKeyScope, string(state.Scope))}
+		if state.GuildID != "" {
+			attrs = append(attrs, slog.String(logKeyGuildID, state.GuildID))
+		}
+
+		attrs = append(attrs,
+			slog.String(logKeyOperation, "bulk_overwrite"),
+			slog.Int(logKeyCount, len(request.ApplicationCommands)),
+			slog.Duration(logKeyDuration, time.Since(start)),
+		)
+
+		if err != nil {
+			c.Logger.Error("application command synchronization failed", append(attrs, slog.Any(logKeyError, err))...)
+
+			if state.Scope == ScopeGuild {
+				err = fmt.Errorf("cannot overwrite guild %q application commands: %w", state.GuildID, err)
+			} else {
+				err = fmt.Errorf("cannot overwrite %s application commands: %w", state.Scope, err)
+			}
+
+			operationErr := &OperationError{
+				Scope:   state.Scope,
+				GuildID: state.GuildID,
+				Command: CommandKey{Name: "", Type: 0},
+				Action:  "",
+				Err:     err,
+			}
+
+			if !c.ContinueOnError {
+				return applied, operationErr
+			}
+
+			errs = append(errs, operationErr)
+
+			continue
+		}
+
+		// match the operations to the application commands returned by Discord.
+		commandMap := make(map[CommandKey]*disgo.ApplicationCommand, len(commands))
+		for _, command := range commands {
+			commandMap[commandKey(command.Type, command.Name)] = command
+		}
+
+		for _, operation := range operations {
+			applied = append(applied, execution{operation: operation, command: commandMap[operation.Key()]})
+		}
+
+		c.Logger.Info("application commands synchronized", attrs...)
+	}
+
+	return applied, newAggregateError(errs)
+}
@@ -0,0 +1,644 @@
+// Command disgoform manages a Discord Bot's application commands from a definition file.
+//
+// Usage:
+//
+//	disgoform <command> [flags]
+//
+// The commands are:
+//
+//	validate  validate the definition file against Discord limits
+//	plan      show the changes required to synchronize application commands
+//	apply     synchronize application commands with Discord
+//	import    output a definition file from the bot's current application commands
+//	destroy   delete the application commands of the bot
+//
+// Exit codes: 0 (success), 1 (error), 2 (plan: application commands are not synchronized).
+// A plan which deletes a protected application command, or more than -max-deletions, is an error.
+//
+// Guild application commands are only synchronized (and destroyed) in the guilds of the definition file,
+// unless another -guild-policy is used.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+
+	"github.com/switchupcb/disgo"
+	"github.com/switchupcb/disgoform"
+)
+
+// Exit Codes.
+const (
+	exitOK    = 0
+	exitError = 1
+	exitDrift = 2
+)
+
+// guildPolicies represents the values of the -guild-policy flag.
+var guildPolicies = map[string]disgoform.GuildPolicy{
+	"all":       disgoform.GuildPolicyAll,
+	"declared":  disgoform.GuildPolicyDeclared,
+	"allowlist": disgoform.GuildPolicyAllowlist,
+}
+
+// guildDiscoveries represents the values of the -guild-discovery flag.
+var guildDiscoveries = map[string]disgoform.GuildDiscovery{
+	"rest":     disgoform.GuildDiscoveryREST,
+	"declared": disgoform.GuildDiscoveryDeclared,
+	"gateway":  disgoform.GuildDiscoveryGateway,
+}
+
+// clientConfig returns the configuration of the Discord client used by a command.
+var clientConfig = disgo.DefaultConfig
+
+// usage represents the usage of the disgoform command.
+const usage = `Usage: disgoform <command> [flags]
+
+Commands:
+  validate  validate the definition file against Discord limits
+  plan      show the changes required to synchronize application commands
+  apply     synchronize application commands with Discord
+  import    output a definition file from the bot's current application commands
+  destroy   delete the application commands of the bot
+
+Run "disgoform <command> -h" to view the flags of a command.
+
+Exit codes: 0 (success), 1 (error), 2 (plan: application commands are not synchronized).
+A plan which deletes a protected application command, or more than -max-deletions, is an error.
+
+Guild application commands are only synchronized (and destroyed) in the guilds of the definition file,
+unless another -guild-policy is used.
+`
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+
+	stop()
+	os.Exit(code)
+}
+
+// run runs the disgoform command and returns its exit code.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+
+		return exitError
+	}
+
+	commands := map[string]func(context.Context, *options) int{
+		"validate": validate,
+		"plan":     plan,
+		"apply":    apply,
+		"import":   importCommands,
+		"destroy":  destroy,
+	}
+
+	command, ok := commands[args[0]]
+	if !ok {
+		if args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+			fmt.Fprint(stdout, usage)
+
+			return exitOK
+		}
+
+		fmt.Fprintf(stderr, "disgoform: unknown command %q\n\n%s", args[0], usage)
+
+		return exitError
+	}
+
+	opts, err := parseOptions(args[0], args[1:], stderr)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitError
+	}
+
+	opts.stdout = stdout
+	opts.stderr = stderr
+
+	return command(ctx, opts)
+}
+
+// options represents the flags of a command.
+type options struct {
+	stdout io.Writer
+	stderr io.Writer
+
+	// config represents the name of the definition file.
+	config string
+
+	// token represents the Discord Bot's token.
+	token string
+
+	// appID represents the Discord Bot's application ID.
+	appID string
+
+	// bearerToken represents the Bearer token used to edit application command permissions.
+	bearerToken string
+
+	// json represents whether output is machine-readable.
+	json bool
+
+	// verbose represents whether synchronization events are logged to stderr.
+	verbose bool
+
+	// bulk represents whether application commands are synchronized using bulk overwrites.
+	bulk bool
+
+	// continueOnError represents whether a synchronization continues after a failed operation.
+	continueOnError bool
+
+	// ownedPrefix represents the name prefix of the application commands which are owned.
+	ownedPrefix string
+
+	// ownedNames represents the names of the application commands which are owned.
+	ownedNames values
+
+	// guildPolicy represents the policy used to determine the guilds which are managed.
+	guildPolicy disgoform.GuildPolicy
+
+	// guildDiscovery represents the method used to discover the guilds of the bot.
+	guildDiscovery disgoform.GuildDiscovery
+
+	// stateFile represents the name of the file which records the application commands that are owned.
+	stateFile string
+
+	// adopt represents whether application commands which are defined, but not recorded in the state file, are adopted.
+	adopt bool
+
+	// maxDeletions represents the maximum amount of application commands a synchronization deletes (negative for no maximum).
+	maxDeletions int
+
+	// output represents the name of the definition file output by import.
+	output string
+
+	// guildIDs represents the guilds of the guild application commands output by import,
+	// or the allowlist of the allowlist guild policy.
+	guildIDs values
+
+	// yes represents whether destroy is confirmed.
+	yes bool
+}
+
+// values represents a repeatable, comma-separated flag (e.g., guild IDs).
+type values []string
+
+// String implements the flag.Value interface.
+func (v *values) String() string {
+	return strings.Join(*v, ",")
+}
+
+// Set implements the flag.Value interface.
+func (v *values) Set(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*v = append(*v, item)
+		}
+	}
+
+	return nil
+}
+
+// parseOptions parses the flags of a command.
+func parseOptions(command string, args []string, stderr io.Writer) (*options, error) {
+	opts := new(options)
+	opts.maxDeletions = -1
+	opts.guildPolicy = disgoform.GuildPolicyDeclared
+
+	flags := flag.NewFlagSet("disgoform "+command, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	flags.StringVar(&opts.config, "config", env("DISGOFORM_CONFIG", "disgoform.yaml"), "definition file (.yaml, .yml, .json, .toml) [$DISGOFORM_CONFIG]")
+	flags.BoolVar(&opts.json, "json", false, "output machine-readable JSON")
+
+	if command != "validate" {
+		flags.StringVar(&opts.token, "token", "", "Discord Bot token [$TOKEN]")
+		flags.StringVar(&opts.appID, "app-id", "", "Discord Bot application ID [$APPID]")
+		flags.BoolVar(&opts.verbose, "v", false, "log synchronization events to stderr")
+	}
+
+	if command == "plan" || command == "apply" {
+		flags.IntVar(&opts.maxDeletions, "max-deletions", -1, "abort when more application commands are deleted (negative for no maximum)")
+		flags.BoolVar(&opts.adopt, "adopt", false, "edit and record defined application commands which are not recorded in the state file")
+	}
+
+	switch command {
+	case "plan", "apply", "destroy":
+		flags.BoolVar(&opts.bulk, "bulk", false, "synchronize each scope using a bulk overwrite")
+		flags.BoolVar(&opts.continueOnError, "continue-on-error", false, "continue after a failed operation")
+		flags.StringVar(&opts.bearerToken, "bearer-token", "", "Bearer token used to edit application command permissions [$BEARER_TOKEN]")
+		flags.StringVar(&opts.ownedPrefix, "owned-prefix", "", "only edit and delete application commands with names that start with a prefix")
+		flags.StringVar(&opts.stateFile, "state", "", "state file which records the application commands that are owned")
+		flags.Var(&opts.ownedNames, "owned-names", "only edit and delete application commands with these names (repeatable, comma-separated)")
+		flags.Func("guild-policy", "guilds which are managed: all, declared (guilds of the definition file), or allowlist (default declared)", func(value string) error {
+			policy, ok := guildPolicies[value]
+			if !ok {
+				return fmt.Errorf("unknown guild policy %q", value)
+			}
+
+			opts.guildPolicy = policy
+
+			return nil
+		})
+		flags.Var(&opts.guildIDs, "guild", "guild ID of the allowlist guild policy (repeatable, comma-separated)")
+		flags.Func("guild-discovery", "method used to discover the guilds of the bot: rest, declared, or gateway (default rest)", func(value string) error {
+			discovery, ok := guildDiscoveries[value]
+			if !ok {
+				return fmt.Errorf("unknown guild discovery %q", value)
+			}
+
+			opts.guildDiscovery = discovery
+
+			return nil
+		})
+	case "import":
+		flags.StringVar(&opts.output, "o", "", "output definition file (.yaml, .yml, .json, .toml, .go) (default stdout as YAML, or JSON with -json)")
+		flags.Var(&opts.guildIDs, "guild", "guild ID of the guild application commands to import (repeatable, comma-separated)")
+	}
+
+	if command == "destroy" {
+		flags.BoolVar(&opts.yes, "yes", false, "confirm the deletion of the application commands")
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if flags.NArg() != 0 {
+		fmt.Fprintf(stderr, "disgoform %s: unexpected arguments %q\n", command, flags.Args())
+
+		return nil, errors.New("unexpected arguments")
+	}
+
+	if command != "import" && len(opts.guildIDs) != 0 && opts.guildPolicy != disgoform.GuildPolicyAllowlist {
+		fmt.Fprintf(stderr, "disgoform %s: -guild requires -guild-policy allowlist\n", command)
+
+		return nil, errors.New("guild without allowlist")
+	}
+
+	// the token is read from the environment after parsing to prevent usage from printing it.
+	if opts.token == "" {
+		opts.token = os.Getenv("TOKEN")
+	}
+
+	if opts.appID == "" {
+		opts.appID = os.Getenv("APPID")
+	}
+
+	if opts.bearerToken == "" {
+		opts.bearerToken = os.Getenv("BEARER_TOKEN")
+	}
+
+	return opts, nil
+}
+
+// env returns the value of an environment variable, or a fallback when it is empty.
+func env(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return fallback
+}
+
+// client returns the Discord client of the options.
+func (o *options) client() (*disgo.Client, error) {
+	if o.token == "" {
+		return nil, errors.New("missing Discord Bot token (use -token or $TOKEN)")
+	}
+
+	if o.appID == "" {
+		return nil, errors.New("missing Discord Bot application ID (use -app-id or $APPID)")
+	}
+
+	return &disgo.Client{
+		ApplicationID:  o.appID,
+		Authentication: disgo.BotToken(o.token),
+		Config:         clientConfig(),
+	}, nil
+}
+
+// syncer returns a Syncer which synchronizes the application commands of a definition file.
+func (o *options) syncer(definitions *disgoform.Definitions) (*disgoform.Syncer, []disgoform.Option, error) {
+	bot, err := o.client()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client:                        bot,
+		GlobalApplicationCommands:     definitions.GlobalApplicationCommands,
+		GuildApplicationCommands:      definitions.GuildApplicationCommands,
+		ApplicationCommandPermissions: definitions.ApplicationCommandPermissions,
+		PreventDestroy:                definitions.PreventDestroy,
+	})
+
+	opts := []disgoform.Option{
+		disgoform.WithGuildPolicy(o.guildPolicy, o.guildIDs...),
+		disgoform.WithGuildDiscovery(o.guildDiscovery),
+	}
+
+	if o.maxDeletions >= 0 {
+		opts = append(opts, disgoform.WithMaxDeletions(o.maxDeletions))
+	}
+
+	if o.bearerToken != "" {
+		opts = append(opts, disgoform.WithPermissionsAuthentication(disgo.BearerToken(o.bearerToken)))
+	}
+
+	if o.bulk {
+		opts = append(opts, disgoform.WithStrategy(disgoform.StrategyBulk))
+	}
+
+	if o.continueOnError {
+		opts = append(opts, disgoform.ContinueOnError())
+	}
+
+	if o.ownedPrefix != "" {
+		opts = append(opts, disgoform.WithOwnedPrefix(o.ownedPrefix))
+	}
+
+	if len(o.ownedNames) != 0 {
+		opts = append(opts, disgoform.WithOwnedNames(o.ownedNames...))
+	}
+
+	if o.stateFile != "" {
+		opts = append(opts, disgoform.WithStateFile(o.stateFile))
+	}
+
+	if o.adopt {
+		opts = append(opts, disgoform.AdoptCommands())
+	}
+
+	if o.verbose {
+		opts = append(opts, disgoform.WithLogger(slog.New(slog.NewTextHandler(o.stderr, nil))))
+	}
+
+	return syncer, opts, nil
+}
+
+// fail outputs an error and returns the error exit code.
+func (o *options) fail(err error) int {
+	if o.json {
+		o.writeJSON(map[string]string{"error": err.Error()})
+	}
+
+	fmt.Fprintf(o.stderr, "disgoform: %v\n", err)
+
+	return exitError
+}
+
+// writeJSON outputs a value as JSON.
+func (o *options) writeJSON(v any) {
+	encoder := json.NewEncoder(o.stdout)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(v); err != nil {
+		fmt.Fprintf(o.stderr, "disgoform: %v\n", err)
+	}
+}
+
+// load loads and validates the definition file.
+func (o *options) load() (*disgoform.Definitions, error) {
+	return disgoform.ValidateFile(o.config) //nolint:wrapcheck
+}
+
+// validate validates the definition file against Discord limits.
+func validate(_ context.Context, o *options) int {
+	_, err := o.load()
+
+	var validationErr *disgoform.ValidationError
+	if err != nil && !errors.As(err, &validationErr) {
+		return o.fail(err)
+	}
+
+	if o.json {
+		output := struct {
+			Violations []disgoform.Violation `json:"violations"`
+			Valid      bool                  `json:"valid"`
+		}{
+			Violations: []disgoform.Violation{},
+			Valid:      err == nil,
+		}
+
+		if validationErr != nil {
+			output.Violations = validationErr.Violations
+		}
+
+		o.writeJSON(output)
+	} else if validationErr != nil {
+		for _, violation := range validationErr.Violations {
+			if violation.Line == 0 {
+				fmt.Fprintf(o.stdout, "%s: %s\n", o.config, violation)
+			} else {
+				fmt.Fprintf(o.stdout, "%s:%d: %s\n", o.config, violation.Line, violation)
+			}
+		}
+	} else {
+		fmt.Fprintf(o.stdout, "%s: valid\n", o.config)
+	}
+
+	if err != nil {
+		return exitError
+	}
+
+	return exitOK
+}
+
+// plan shows the changes required to synchronize the application commands of the definition file.
+func plan(ctx context.Context, o *options) int {
+	definitions, err := o.load()
+	if err != nil {
+		return o.fail(err)
+	}
+
+	syncer, opts, err := o.syncer(definitions)
+	if err != nil {
+		return o.fail(err)
+	}
+
+	// a plan which deletes protected application commands (or too many) is an error, as it is for apply.
+	result, err := syncer.SyncContext(ctx, append(opts, disgoform.DryRun())...)
+	if err != nil {
+		return o.fail(err)
+	}
+
+	changes := len(result.Operations) != 0 || slices.ContainsFunc(result.Permissions, func(permissions *disgoform.PermissionsResult) bool {
+		return permissions.Action != disgoform.ActionNoOp
+	})
+
+	if o.json {
+		o.writeJSON(result)
+	} else {
+		writePlan(o.stdout, result)
+	}
+
+	if changes {
+		return exitDrift
+	}
+
+	return exitOK
+}
+
+// writePlan outputs the changes of a dry run synchronization.
+func writePlan(w io.Writer, result *disgoform.Result) {
+	changes := &disgoform.ChangePlan{
+		Operations: result.Operations,
+		States:     nil,
+	}
+
+	unmanaged := 0
+	for _, scope := range result.Scopes {
+		unmanaged += len(scope.Unmanaged)
+	}
+
+	fmt.Fprint(w, changes)
+
+	if unmanaged != 0 {
+		fmt.Fprintf(w, "%d application commands are not managed.\n", unmanaged)
+	}
+
+	count := len(changes.Operations)
+
+	for _, permissions := range result.Permissions {
+		if permissions.Action != disgoform.ActionNoOp {
+			fmt.Fprintln(w, permissions)
+
+			count++
+		}
+	}
+
+	if count == 0 {
+		fmt.Fprintln(w, "No changes. Application commands are synchronized.")
+
+		return
+	}
+
+	fmt.Fprintf(w, "\n%d changes.\n", count)
+}
+
+// apply synchronizes the application commands of the definition file with Discord.
+func apply(ctx context.Context, o *options) int {
+	definitions, err := o.load()
+	if err != nil {
+		return o.fail(err)
+	}
+
+	return o.sync(ctx, definitions)
+}
+
+// destroy deletes the global application commands of the bot and the guild application commands of the managed guilds.
+//
+// The application commands which are protected by the definition file (when it exists) are never deleted.
+func destroy(ctx context.Context, o *options) int {
+	if !o.yes {
+		return o.fail(errors.New("destroy deletes the application commands of the bot: use -yes to confirm"))
+	}
+
+	definitions := new(disgoform.Definitions)
+
+	if _, err := os.Stat(o.config); err == nil {
+		if definitions, err = o.load(); err != nil {
+			return o.fail(err)
+		}
+	}
+
+	syncer, opts, err := o.syncer(definitions)
+	if err != nil {
+		return o.fail(err)
+	}
+
+	result, err := syncer.DestroyContext(ctx, syncer.Config.Client.ApplicationID, opts...)
+
+	return o.writeResult(result, err)
+}
+
+// sync synchronizes application commands and outputs the result.
+func (o *options) sync(ctx context.Context, definitions *disgoform.Definitions) int {
+	syncer, opts, err := o.syncer(definitions)
+	if err != nil {
+		return o.fail(err)
+	}
+
+	result, err := syncer.SyncContext(ctx, opts...)
+
+	return o.writeResult(result, err)
+}
+
+// writeResult outputs the result of a synchronization and returns its exit code.
+func (o *options) writeResult(result *disgoform.Result, err error) int {
+	if result != nil {
+		if o.json {
+			o.writeJSON(result)
+		} else {
+			fmt.Fprint(o.stdout, result)
+		}
+	}
+
+	if err != nil {
+		fmt.Fprintf(o.stderr, "disgoform: %v\n", err)
+
+		return exitError
+	}
+
+	return exitOK
+}
+
+// importCommands outputs a definition file from the bot's current application commands.
+func importCommands(ctx context.Context, o *options) int {
+	bot, err := o.client()
+	if err != nil {
+		return o.fail(err)
+	}
+
+	definitions, err := disgoform.ImportContext(ctx, bot, o.guildIDs)
+	if err != nil {
+		return o.fail(err)
+	}
+
+	var output []byte
+
+	switch name := o.output; {
+	case strings.HasSuffix(name, ".go"):
+		var file string
+
+		file, err = disgoform.ConfigFile(definitions.GlobalApplicationCommands, definitions.GuildApplicationCommands)
+		output = []byte(file)
+	case name == "" && o.json:
+		output, err = disgoform.DefinitionFile("stdout.json", definitions)
+	case name == "":
+		output, err = disgoform.DefinitionFile("stdout.yaml", definitions)
+	default:
+		output, err = disgoform.DefinitionFile(name, definitions)
+	}
+
+	if err != nil {
+		return o.fail(err)
+	}
+
+	if o.output == "" {
+		if _, err := o.stdout.Write(output); err != nil {
+			return o.fail(err)
+		}
+
+		return exitOK
+	}
+
+	if err := os.WriteFile(o.output, output, 0o644); err != nil { //nolint:gosec
+		return o.fail(err)
+	}
+
+	return exitOK
+}
@@ -0,0 +1,325 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/switchupcb/disgo"
+	"github.com/switchupcb/disgoform"
+)
+
+// testCurrentCommands represents the current global application commands served by the fake Discord API.
+const testCurrentCommands = `[
+	{"id": "1", "application_id": "0", "name": "main", "description": "A basic command.", "version": "1", "type": 1}
+]`
+
+// testGuildCommands represents the current guild application commands of a guild which is not in the definition file.
+const testGuildCommands = `[
+	{"id": "2", "application_id": "0", "guild_id": "1", "name": "admin", "description": "A command of another service.", "version": "1", "type": 1}
+]`
+
+// testDefinitionFile represents a definition file which matches the current global application commands.
+const testDefinitionFile = `global:
+  - name: main
+    description: A basic command.
+`
+
+// testRun represents parameters used to test the disgoform command.
+type testRun struct {
+	name string
+	args []string
+
+	// file represents the content of the definition file passed using -config (none when empty).
+	file string
+
+	// commands represents the current global application commands (JSON).
+	commands string
+
+	// guildCommands represents the current guild application commands of guild 1, which the bot is in (JSON).
+	guildCommands string
+
+	// code represents the expected exit code.
+	code int
+
+	// stdout represents a string the standard output must contain.
+	stdout string
+
+	// stderr represents a string the standard error must contain.
+	stderr string
+
+	// requests represents the expected amount of requests which modify application commands.
+	requests int
+}
+
+// fakeDiscord serves the current global application commands and the current guild application commands of guild 1
+// to the disgoform command, and returns the requests which modify application commands.
+func fakeDiscord(t *testing.T, commands, guildCommands string) *[]string {
+	t.Helper()
+
+	var requests []string
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method != http.MethodGet:
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/api/v10/applications/0/commands" && commands != "":
+			_, _ = w.Write([]byte(commands))
+		case r.URL.Path == "/api/v10/users/@me/guilds" && guildCommands != "":
+			_, _ = w.Write([]byte(`[{"id": "1", "name": "guild"}]`))
+		case r.URL.Path == "/api/v10/applications/0/guilds/1/commands" && guildCommands != "":
+			_, _ = w.Write([]byte(guildCommands))
+		default:
+			_, _ = w.Write([]byte("[]"))
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	defaultConfig := clientConfig
+	clientConfig = func() *disgo.Config {
+		config := defaultConfig()
+		config.Request.Client.Dial = func(string) (net.Conn, error) {
+			return net.Dial("tcp", server.Listener.Addr().String())
+		}
+		config.Request.Client.TLSConfig = &tls.Config{InsecureSkipVerify: true} //nolint:exhaustruct,gosec
+
+		return config
+	}
+
+	t.Cleanup(func() { clientConfig = defaultConfig })
+
+	return &requests
+}
+
+// TestRun tests the commands, flags, output, and exit codes of the disgoform command.
+func TestRun(t *testing.T) {
+	t.Setenv("TOKEN", "")
+	t.Setenv("APPID", "")
+	t.Setenv("DISGOFORM_CONFIG", "")
+
+	bot := []string{"-token", "TOKEN", "-app-id", "0"}
+
+	tests := []testRun{
+		{name: "no command", args: nil, code: exitError, stderr: "Usage"},
+		{name: "help", args: []string{"help"}, code: exitOK, stdout: "Usage"},
+		{name: "unknown command", args: []string{"deploy"}, code: exitError, stderr: `unknown command "deploy"`},
+		{name: "unexpected arguments", args: []string{"validate", "extra"}, code: exitError, stderr: "unexpected arguments"},
+		{name: "validate", args: []string{"validate"}, file: testDefinitionFile, code: exitOK, stdout: "valid"},
+		{name: "validate json", args: []string{"validate", "-json"}, file: testDefinitionFile, code: exitOK, stdout: `"valid": true`},
+		{
+			name:   "validate violation",
+			args:   []string{"validate", "-json"},
+			file:   "global:\n  - name: Main\n    description: A basic command.\n",
+			code:   exitError,
+			stdout: `"path": "GlobalApplicationCommands[0].name"`,
+		},
+		{
+			name:   "validate violation line",
+			args:   []string{"validate"},
+			file:   "global:\n  - name: Main\n    description: A basic command.\n",
+			code:   exitError,
+			stdout: `disgoform.yaml:2: GlobalApplicationCommands[0].name: must be lowercase`,
+		},
+		{name: "validate missing file", args: []string{"validate", "-config", "missing.yaml"}, code: exitError, stderr: "missing.yaml"},
+		{name: "plan missing token", args: []string{"plan"}, file: testDefinitionFile, code: exitError, stderr: "missing Discord Bot token"},
+		{name: "plan", args: append([]string{"plan"}, bot...), file: testDefinitionFile, commands: testCurrentCommands, code: exitOK, stdout: "No changes."},
+		{name: "plan drift", args: append([]string{"plan"}, bot...), file: testDefinitionFile, code: exitDrift, stdout: `create CHAT_INPUT "main"`},
+		{name: "plan drift json", args: append([]string{"plan", "-json"}, bot...), file: testDefinitionFile, code: exitDrift, stdout: `"dry_run": true`},
+		{
+			name:     "plan max deletions",
+			args:     append([]string{"plan", "-max-deletions", "0"}, bot...),
+			file:     "global: []\n",
+			commands: testCurrentCommands,
+			code:     exitError,
+			stderr:   "cannot delete 1 application commands (max: 0)",
+		},
+		{
+			name:     "plan max deletions json",
+			args:     append([]string{"plan", "-json", "-max-deletions", "0"}, bot...),
+			file:     "global: []\n",
+			commands: testCurrentCommands,
+			code:     exitError,
+			stdout:   `"error"`,
+		},
+		{
+			name:     "plan prevent destroy",
+			args:     append([]string{"plan"}, bot...),
+			file:     "global:\n  - name: main\n    type: MESSAGE\n    prevent_destroy: true\n",
+			commands: testCurrentCommands,
+			code:     exitError,
+			stderr:   `cannot destroy protected application commands: global: recreate MESSAGE "main"`,
+		},
+		{
+			name:          "apply undeclared guild",
+			args:          append([]string{"apply"}, bot...),
+			file:          testDefinitionFile,
+			commands:      testCurrentCommands,
+			guildCommands: testGuildCommands,
+			code:          exitOK,
+		},
+		{
+			name:          "apply guild policy all",
+			args:          append([]string{"apply", "-guild-policy", "all"}, bot...),
+			file:          testDefinitionFile,
+			commands:      testCurrentCommands,
+			guildCommands: testGuildCommands,
+			code:          exitOK,
+			stdout:        `delete CHAT_INPUT "admin"`,
+			requests:      1,
+		},
+		{
+			name:          "apply guild allowlist",
+			args:          append([]string{"apply", "-guild-policy", "allowlist", "-guild", "1"}, bot...),
+			file:          testDefinitionFile,
+			commands:      testCurrentCommands,
+			guildCommands: testGuildCommands,
+			code:          exitOK,
+			stdout:        `delete CHAT_INPUT "admin"`,
+			requests:      1,
+		},
+		{
+			name:          "apply guild declared discovery",
+			args:          append([]string{"apply", "-guild-policy", "all", "-guild-discovery", "declared"}, bot...),
+			file:          testDefinitionFile,
+			commands:      testCurrentCommands,
+			guildCommands: testGuildCommands,
+			code:          exitOK,
+		},
+		{
+			name:     "plan owned names",
+			args:     append([]string{"plan", "-owned-names", "other,another"}, bot...),
+			file:     "global: []\n",
+			commands: testCurrentCommands,
+			code:     exitOK,
+			stdout:   "1 application commands are not managed",
+		},
+		{name: "guild without allowlist", args: append([]string{"apply", "-guild", "1"}, bot...), file: testDefinitionFile, code: exitError, stderr: "-guild requires -guild-policy allowlist"},
+		{name: "unknown guild policy", args: append([]string{"apply", "-guild-policy", "every"}, bot...), file: testDefinitionFile, code: exitError, stderr: `unknown guild policy "every"`},
+		{name: "destroy without confirmation", args: append([]string{"destroy"}, bot...), commands: testCurrentCommands, code: exitError, stderr: "use -yes to confirm"},
+		{
+			name:          "destroy",
+			args:          append([]string{"destroy", "-yes"}, bot...),
+			commands:      testCurrentCommands,
+			guildCommands: testGuildCommands,
+			code:          exitOK,
+			stdout:        `delete CHAT_INPUT "main"`,
+			requests:      1,
+		},
+	}
+
+	for _, test := range tests {
+		requests := fakeDiscord(t, test.commands, test.guildCommands)
+
+		args := test.args
+		if test.file != "" {
+			name := filepath.Join(t.TempDir(), "disgoform.yaml")
+			if err := os.WriteFile(name, []byte(test.file), 0o600); err != nil {
+				t.Fatalf("%s: %v", test.name, err)
+			}
+
+			args = append(args, "-config", name)
+		} else if len(args) != 0 && args[0] != "validate" {
+			args = append(args, "-config", filepath.Join(t.TempDir(), "disgoform.yaml"))
+		}
+
+		var stdout, stderr bytes.Buffer
+
+		if code := run(context.Background(), args, &stdout, &stderr); code != test.code {
+			t.Errorf("%s: expected exit code %d, got %d (stderr: %s)", test.name, test.code, code, stderr.String())
+		}
+
+		if !strings.Contains(stdout.String(), test.stdout) {
+			t.Errorf("%s: expected stdout to contain %q, got: %s", test.name, test.stdout, stdout.String())
+		}
+
+		if !strings.Contains(stderr.String(), test.stderr) {
+			t.Errorf("%s: expected stderr to contain %q, got: %s", test.name, test.stderr, stderr.String())
+		}
+
+		if len(*requests) != test.requests {
+			t.Errorf("%s: expected %d requests which modify application commands, got: %v", test.name, test.requests, *requests)
+		}
+
+		if strings.Contains(strings.Join(test.args, " "), "-json") && !json.Valid(stdout.Bytes()) {
+			t.Errorf("%s: expected JSON output, got: %s", test.name, stdout.String())
+		}
+	}
+}
+
+// testParseOptions represents parameters used to test the precedence of flags and environment variables.
+type testParseOptions struct {
+	name     string
+	args     []string
+	env      map[string]string
+	expected options
+}
+
+// TestParseOptions tests that flags take precedence over environment variables.
+func TestParseOptions(t *testing.T) {
+	tests := []testParseOptions{
+		{
+			name:     "defaults",
+			args:     nil,
+			env:      map[string]string{"TOKEN": "", "APPID": "", "BEARER_TOKEN": "", "DISGOFORM_CONFIG": ""},
+			expected: options{config: "disgoform.yaml", maxDeletions: -1, guildPolicy: disgoform.GuildPolicyDeclared}, //nolint:exhaustruct
+		},
+		{
+			name:     "environment",
+			args:     nil,
+			env:      map[string]string{"TOKEN": "env-token", "APPID": "1", "BEARER_TOKEN": "env-bearer", "DISGOFORM_CONFIG": "env.yaml"},
+			expected: options{config: "env.yaml", token: "env-token", appID: "1", bearerToken: "env-bearer", maxDeletions: -1, guildPolicy: disgoform.GuildPolicyDeclared}, //nolint:exhaustruct
+		},
+		{
+			name:     "flags",
+			args:     []string{"-config", "flag.yaml", "-token", "flag-token", "-app-id", "2", "-bearer-token", "flag-bearer", "-max-deletions", "3"},
+			env:      map[string]string{"TOKEN": "env-token", "APPID": "1", "BEARER_TOKEN": "env-bearer", "DISGOFORM_CONFIG": "env.yaml"},
+			expected: options{config: "flag.yaml", token: "flag-token", appID: "2", bearerToken: "flag-bearer", maxDeletions: 3, guildPolicy: disgoform.GuildPolicyDeclared}, //nolint:exhaustruct
+		},
+		{
+			name: "guild flags",
+			args: []string{"-guild-policy", "allowlist", "-guild", "1,2", "-guild", "3", "-guild-discovery", "gateway", "-owned-names", "main, admin"},
+			env:  map[string]string{"TOKEN": "", "APPID": "", "BEARER_TOKEN": "", "DISGOFORM_CONFIG": ""},
+			expected: options{ //nolint:exhaustruct
+				config:         "disgoform.yaml",
+				maxDeletions:   -1,
+				guildPolicy:    disgoform.GuildPolicyAllowlist,
+				guildIDs:       values{"1", "2", "3"},
+				guildDiscovery: disgoform.GuildDiscoveryGateway,
+				ownedNames:     values{"main", "admin"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		for key, value := range test.env {
+			t.Setenv(key, value)
+		}
+
+		var stderr bytes.Buffer
+
+		opts, err := parseOptions("apply", test.args, &stderr)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		if opts.config != test.expected.config || opts.token != test.expected.token || opts.appID != test.expected.appID ||
+			opts.bearerToken != test.expected.bearerToken || opts.maxDeletions != test.expected.maxDeletions ||
+			opts.guildPolicy != test.expected.guildPolicy || opts.guildDiscovery != test.expected.guildDiscovery ||
+			!slices.Equal(opts.guildIDs, test.expected.guildIDs) || !slices.Equal(opts.ownedNames, test.expected.ownedNames) {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.expected, *opts)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package disgoform
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/switchupcb/disgo"
+)
+
+// Equivalent returns whether two application commands are semantically equal.
+//
+// Equivalent normalizes the default values Discord fills in (i.e., NormalizeGlobalApplicationCommand)
+// prior to comparing disgo.CreateGlobalApplicationCommand and disgo.CreateGuildApplicationCommand values.
+// Other values are compared using reflect.DeepEqual.
+//
+// The order of options and choices is significant: Discord displays options and choices to users in their defined order,
+// and requires required options to be defined before optional options. So, reordered options or choices are not equivalent,
+// and a reorder is synchronized as an edit.
+func Equivalent(x, y any) bool {
+	return reflect.DeepEqual(normalize(x), normalize(y))
+}
+
+// normalize normalizes an application command.
+func normalize(v any) any {
+	switch command := v.(type) {
+	case disgo.CreateGlobalApplicationCommand:
+		return NormalizeGlobalApplicationCommand(command)
+
+	case *disgo.CreateGlobalApplicationCommand:
+		if command != nil {
+			return NormalizeGlobalApplicationCommand(*command)
+		}
+
+	case disgo.CreateGuildApplicationCommand:
+		return NormalizeGuildApplicationCommand(command)
+
+	case *disgo.CreateGuildApplicationCommand:
+		if command != nil {
+			return NormalizeGuildApplicationCommand(*command)
+		}
+	}
+
+	return v
+}
+
+// NormalizeGlobalApplicationCommand returns a copy of a global application command
+// with the default values Discord fills in.
+//
+// A nil type is CHAT_INPUT, a nil description is empty, a nil NSFW flag is false,
+// and nil permissions are allowed for everyone. Unset integration types default to GUILD_INSTALL,
+// while unset contexts default to every interaction context.
+//
+// Empty slices and maps are nil. Integration types, contexts, and channel types are sorted
+// because their order is insignificant, while the order of options and choices is preserved.
+func NormalizeGlobalApplicationCommand(command disgo.CreateGlobalApplicationCommand) disgo.CreateGlobalApplicationCommand {
+	command.NameLocalizations = normalizeLocalizations(command.NameLocalizations)
+	command.Description = normalizeDescription(command.Description)
+	command.DescriptionLocalizations = normalizeLocalizations(command.DescriptionLocalizations)
+	command.Options = normalizeOptions(command.Options)
+	command.DefaultMemberPermissions = normalizePermissions(command.DefaultMemberPermissions)
+	command.Type = normalizeType(command.Type)
+	command.NSFW = normalizeBool(command.NSFW)
+
+	command.IntegrationTypes = normalizeFlags(command.IntegrationTypes)
+	if command.IntegrationTypes == nil {
+		command.IntegrationTypes = []disgo.Flag{disgo.FlagApplicationIntegrationTypeGUILD_INSTALL}
+	}
+
+	command.Contexts = normalizeFlags(command.Contexts)
+	if command.Contexts == nil {
+		command.Contexts = []disgo.Flag{
+			disgo.FlagInteractionContextTypeGUILD,
+			disgo.FlagInteractionContextTypeBOT_DM,
+			disgo.FlagInteractionContextTypePRIVATE_CHANNEL,
+		}
+	}
+
+	return command
+}
+
+// NormalizeGuildApplicationCommand returns a copy of a guild application command
+// with the default values Discord fills in.
+//
+// Guild application commands are normalized in the same manner as NormalizeGlobalApplicationCommand.
+func NormalizeGuildApplicationCommand(command disgo.CreateGuildApplicationCommand) disgo.CreateGuildApplicationCommand {
+	command.NameLocalizations = normalizeLocalizations(command.NameLocalizations)
+	command.Description = normalizeDescription(command.Description)
+	command.DescriptionLocalizations = normalizeLocalizations(command.DescriptionLocalizations)
+	command.Options = normalizeOptions(command.Options)
+	command.DefaultMemberPermissions = normalizePermissions(command.DefaultMemberPermissions)
+	command.Type = normalizeType(command.Type)
+	command.NSFW = normalizeBool(command.NSFW)
+
+	return command
+}
+
+// normalizeOptions returns a normalized copy of application command options.
+func normalizeOptions(options []*disgo.ApplicationCommandOption) []*disgo.ApplicationCommandOption {
+	if len(options) == 0 {
+		return nil
+	}
+
+	normalized := make([]*disgo.ApplicationCommandOption, len(options))
+
+	for i, option := range options {
+		if option == nil {
+			continue
+		}
+
+		copied := *option
+		copied.NameLocalizations = normalizeLocalizations(copied.NameLocalizations)
+		copied.DescriptionLocalizations = normalizeLocalizations(copied.DescriptionLocalizations)
+		copied.Required = normalizeBool(copied.Required)
+		copied.Choices = normalizeChoices(copied.Choices)
+		copied.Options = normalizeOptions(copied.Options)
+		copied.ChannelTypes = normalizeFlags(copied.ChannelTypes)
+		copied.Autocomplete = normalizeBool(copied.Autocomplete)
+
+		normalized[i] = &copied
+	}
+
+	return normalized
+}
+
+// normalizeChoices returns a normalized copy of application command option choices.
+func normalizeChoices(choices []*disgo.ApplicationCommandOptionChoice) []*disgo.ApplicationCommandOptionChoice {
+	if len(choices) == 0 {
+		return nil
+	}
+
+	normalized := make([]*disgo.ApplicationCommandOptionChoice, len(choices))
+
+	for i, choice := range choices {
+		if choice == nil {
+			continue
+		}
+
+		copied := *choice
+		copied.NameLocalizations = normalizeLocalizations(copied.NameLocalizations)
+
+		normalized[i] = &copied
+	}
+
+	return normalized
+}
+
+// normalizeLocalizations returns nil when a localization map is empty.
+func normalizeLocalizations(localizations *map[string]string) *map[string]string {
+	if localizations == nil || len(*localizations) == 0 {
+		return nil
+	}
+
+	return localizations
+}
+
+// normalizeDescription returns an empty description when a description is nil.
+func normalizeDescription(description *string) *string {
+	if description == nil {
+		return new(string)
+	}
+
+	return description
+}
+
+// normalizePermissions returns null permissions when permissions are nil.
+func normalizePermissions(permissions **string) **string {
+	if permissions == nil {
+		return new(*string)
+	}
+
+	return permissions
+}
+
+// normalizeType returns the CHAT_INPUT application command type when a type is nil.
+func normalizeType(flag *disgo.Flag) *disgo.Flag {
+	if flag == nil {
+		return disgo.Pointer(disgo.FlagApplicationCommandTypeCHAT_INPUT)
+	}
+
+	return flag
+}
+
+// normalizeBool returns false when a bool is nil.
+func normalizeBool(b *bool) *bool {
+	if b == nil {
+		return disgo.Pointer(false)
+	}
+
+	return b
+}
+
+// normalizeFlags returns a sorted copy of flags or nil when flags are empty.
+func normalizeFlags[T ~[]disgo.Flag](flags T) T {
+	if len(flags) == 0 {
+		return nil
+	}
+
+	sorted := slices.Clone(flags)
+	slices.Sort(sorted)
+
+	return sorted
+}
@@ -0,0 +1,170 @@
+package disgoform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/switchupcb/disgo"
+)
+
+// ProtectedCommand represents an application command which is never deleted (or recreated) by a synchronization.
+type ProtectedCommand struct {
+	// GuildID represents the guild of a guild application command (empty for a global application command).
+	GuildID string
+
+	// Name represents the name of the application command.
+	Name string
+
+	// Type represents the type of the application command (default: CHAT_INPUT).
+	Type *disgo.Flag
+}
+
+// Key returns the key of the protected application command.
+func (p *ProtectedCommand) Key() CommandKey {
+	return commandKey(p.Type, p.Name)
+}
+
+// protected returns whether an application command is protected by a list of protected application commands.
+func protected(commands []ProtectedCommand, guildID string, typ *disgo.Flag, name string) bool {
+	return slices.ContainsFunc(commands, func(protected ProtectedCommand) bool {
+		return protected.GuildID == guildID && protected.Key() == commandKey(typ, name)
+	})
+}
+
+// DeletionError represents an error that occurs when a plan deletes a protected application command,
+// or more application commands than the maximum amount of deletions.
+//
+// No operation of a plan is executed when a DeletionError occurs.
+type DeletionError struct {
+	// Protected represents the delete and recreate operations of protected application commands.
+	Protected []*Operation
+
+	// Deletions represents the amount of application commands the plan deletes (including recreated application commands).
+	Deletions int
+
+	// MaxDeletions represents the maximum amount of deletions (-1 when there is no maximum).
+	MaxDeletions int
+}
+
+// Error implements the error interface.
+func (e *DeletionError) Error() string {
+	var b strings.Builder
+
+	if len(e.Protected) != 0 {
+		operations := make([]string, len(e.Protected))
+		for i, operation := range e.Protected {
+			operations[i] = operation.String()
+		}
+
+		fmt.Fprintf(&b, "cannot destroy protected application commands: %s", strings.Join(operations, "; "))
+	}
+
+	if e.MaxDeletions >= 0 && e.Deletions > e.MaxDeletions {
+		if b.Len() != 0 {
+			b.WriteString("; ")
+		}
+
+		fmt.Fprintf(&b, "cannot delete %d application commands (max: %d)", e.Deletions, e.MaxDeletions)
+	}
+
+	return b.String()
+}
+
+// checkDeletions returns a *DeletionError when a plan deletes a protected application command,
+// or more application commands than the maximum amount of deletions of a configuration.
+func checkDeletions(c *Config, plan *ChangePlan) error {
+	if plan == nil {
+		return nil
+	}
+
+	err := &DeletionError{
+		Protected:    nil,
+		Deletions:    0,
+		MaxDeletions: -1,
+	}
+
+	if c.MaxDeletions != nil {
+		err.MaxDeletions = *c.MaxDeletions
+	}
+
+	for _, operation := range plan.Operations {
+		if operation.Action != ActionDelete && operation.Action != ActionRecreate {
+			continue
+		}
+
+		// a recreate operation deletes the current application command (and its ID and permission overwrites).
+		err.Deletions++
+
+		if protected(c.PreventDestroy, operation.GuildID, &operation.Type, operation.Name) {
+			err.Protected = append(err.Protected, operation)
+		}
+	}
+
+	if len(err.Protected) == 0 && (err.MaxDeletions < 0 || err.Deletions <= err.MaxDeletions) {
+		return nil
+	}
+
+	return err
+}
+
+// Destroy deletes the Global and Guild application commands of a bot.
+//
+// The confirmation must be the application ID of the bot, so an accidental call does not delete any application command.
+func Destroy(bot *disgo.Client, confirmation string, opts ...Option) (*Result, error) {
+	return defaultSyncer(bot).DestroyContext(context.Background(), confirmation, opts...)
+}
+
+// DestroyContext deletes the Global and Guild application commands of a bot using a context.
+//
+// The confirmation must be the application ID of the bot, so an accidental call does not delete any application command.
+func DestroyContext(ctx context.Context, bot *disgo.Client, confirmation string, opts ...Option) (*Result, error) {
+	return defaultSyncer(bot).DestroyContext(ctx, confirmation, opts...)
+}
+
+// Destroy deletes the Global and Guild application commands of the Syncer's bot.
+//
+// The confirmation must be the application ID of the bot, so an accidental call does not delete any application command.
+func (s *Syncer) Destroy(confirmation string, opts ...Option) (*Result, error) {
+	return s.DestroyContext(context.Background(), confirmation, opts...)
+}
+
+// DestroyContext deletes the Global and Guild application commands of the Syncer's bot using a context.
+//
+// The confirmation must be the application ID of the bot, so an accidental call does not delete any application command.
+//
+// DestroyContext deletes the guild application commands of the discovered guilds which are managed by the guild policy,
+// and only deletes owned application commands (WithOwnedPrefix, WithOwnedNames, WithStateFile).
+// A *DeletionError is returned without deleting any application command when a protected application command exists,
+// but the maximum amount of deletions is ignored.
+//
+// DestroyContext stops deleting application commands when the context is canceled
+// after the request in progress (including its rate limit wait).
+func (s *Syncer) DestroyContext(ctx context.Context, confirmation string, opts ...Option) (*Result, error) {
+	c, err := s.config(opts)
+	if err != nil {
+		return nil, fmt.Errorf("Destroy: %w", err)
+	}
+
+	if confirmation == "" || confirmation != c.Client.ApplicationID {
+		return nil, errors.New("Destroy: cannot destroy application commands without confirmation (use the application ID of the bot)")
+	}
+
+	// the destruction is confirmed.
+	c.MaxDeletions = nil
+
+	plan, err := planApplicationCommands(ctx, c, true)
+	if plan == nil || (err != nil && ctx.Err() != nil) {
+		return newResult(nil, nil, c.DryRun), fmt.Errorf("Destroy: %w", err)
+	}
+
+	// destroy the scopes which are planned (ContinueOnError).
+	result, applyErr := syncPlan(ctx, c, plan, "Destroy")
+	if err != nil {
+		return result, fmt.Errorf("Destroy: %w", mergeErrors(err, errors.Unwrap(applyErr)))
+	}
+
+	return result, applyErr
+}
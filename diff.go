@@ -0,0 +1,223 @@
+package disgoform
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/switchupcb/disgo"
+)
+
+// Change represents a difference between a field of a current and defined application command.
+type Change struct {
+	// From represents the value of the current application command field (nil when the field is added).
+	From any
+
+	// To represents the value of the defined application command field (nil when the field is removed).
+	To any
+
+	// Path represents the path of the field (e.g., options[1].choices[0].name).
+	Path string
+}
+
+// String returns a human-readable representation of the change.
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Path, formatValue(c.From), formatValue(c.To))
+}
+
+// Diff represents the differences between a current and defined application command.
+type Diff []Change
+
+// DiffGlobalApplicationCommands returns the differences between a current and defined global application command.
+func DiffGlobalApplicationCommands(current, defined disgo.CreateGlobalApplicationCommand) Diff {
+	var d Diff
+	d.walk("", reflect.ValueOf(current), reflect.ValueOf(defined))
+
+	return d
+}
+
+// DiffGuildApplicationCommands returns the differences between a current and defined guild application command.
+func DiffGuildApplicationCommands(current, defined disgo.CreateGuildApplicationCommand) Diff {
+	var d Diff
+	d.walk("", reflect.ValueOf(current), reflect.ValueOf(defined))
+
+	return d
+}
+
+// Fields returns the top-level fields of the differences.
+func (d Diff) Fields() []string {
+	var fields []string
+
+	for _, change := range d {
+		field, _, _ := strings.Cut(change.Path, ".")
+		field, _, _ = strings.Cut(field, "[")
+
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// String returns a human-readable unified view of the differences.
+func (d Diff) String() string {
+	return d.Format(false)
+}
+
+// ANSI escape codes.
+const (
+	colorReset  = "\x1b[0m"
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+)
+
+// Format returns a human-readable unified view of the differences.
+//
+// Each line is prefixed by + (added), - (removed), or ~ (changed),
+// and colored using ANSI escape codes when color is true.
+func (d Diff) Format(color bool) string {
+	var b strings.Builder
+
+	for _, change := range d {
+		prefix, code := "~", colorYellow
+
+		switch {
+		case change.From == nil:
+			prefix, code = "+", colorGreen
+		case change.To == nil:
+			prefix, code = "-", colorRed
+		}
+
+		if color {
+			b.WriteString(code)
+		}
+
+		b.WriteString(prefix)
+		b.WriteByte(' ')
+		b.WriteString(change.String())
+
+		if color {
+			b.WriteString(colorReset)
+		}
+
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
+
+// walk adds the differences between two values at a path to the diff.
+func (d *Diff) walk(path string, from, to reflect.Value) {
+	// a nil pointer, slice, or map is different from a non-nil value.
+	if isNil(from) || isNil(to) {
+		if isNil(from) != isNil(to) {
+			*d = append(*d, Change{From: value(from), To: value(to), Path: path})
+		}
+
+		return
+	}
+
+	switch from.Kind() { //nolint:exhaustive
+	case reflect.Pointer, reflect.Interface:
+		d.walk(path, from.Elem(), to.Elem())
+
+	case reflect.Struct:
+		for i := range from.NumField() {
+			name, _, _ := strings.Cut(from.Type().Field(i).Tag.Get("json"), ",")
+			if name == "" || name == "-" {
+				continue
+			}
+
+			d.walk(join(path, name), from.Field(i), to.Field(i))
+		}
+
+	case reflect.Slice:
+		for i := range max(from.Len(), to.Len()) {
+			elementPath := path + "[" + strconv.Itoa(i) + "]"
+
+			switch {
+			case i >= from.Len():
+				*d = append(*d, Change{From: nil, To: value(to.Index(i)), Path: elementPath})
+			case i >= to.Len():
+				*d = append(*d, Change{From: value(from.Index(i)), To: nil, Path: elementPath})
+			default:
+				d.walk(elementPath, from.Index(i), to.Index(i))
+			}
+		}
+
+	case reflect.Map:
+		keys := make([]string, 0, from.Len()+to.Len())
+		for _, key := range append(from.MapKeys(), to.MapKeys()...) {
+			if !slices.Contains(keys, key.String()) {
+				keys = append(keys, key.String())
+			}
+		}
+
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			k := reflect.ValueOf(key).Convert(from.Type().Key())
+			d.walk(path+"["+strconv.Quote(key)+"]", from.MapIndex(k), to.MapIndex(k))
+		}
+
+	default:
+		if !reflect.DeepEqual(from.Interface(), to.Interface()) {
+			*d = append(*d, Change{From: value(from), To: value(to), Path: path})
+		}
+	}
+}
+
+// isNil returns whether a value is invalid (i.e., missing map entry) or nil.
+func isNil(v reflect.Value) bool {
+	if !v.IsValid() {
+		return true
+	}
+
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+		return v.IsNil()
+	}
+
+	return false
+}
+
+// value returns the underlying value of a diff value.
+func value(v reflect.Value) any {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		v = v.Elem()
+	}
+
+	if isNil(v) {
+		return nil
+	}
+
+	return v.Interface()
+}
+
+// join joins a path and field name.
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+// formatValue returns a human-readable representation of a diff value.
+func formatValue(v any) string {
+	if v == nil {
+		return "null"
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(b)
+}
@@ -0,0 +1,105 @@
+package disgoform
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// OperationError represents an error that occurs while synchronizing an application command
+// or the application commands of a guild.
+type OperationError struct {
+	// Scope represents the scope of the application command.
+	Scope Scope
+
+	// GuildID represents the guild of a guild application command.
+	GuildID string
+
+	// Command represents the key of the application command.
+	//
+	// Command is empty when the error occurs while planning or bulk overwriting the application commands of a scope.
+	Command CommandKey
+
+	// Action represents the action of the operation (empty when the error occurs while planning).
+	Action Action
+
+	// Err represents the underlying error (e.g., disgo.ErrorRequest).
+	Err error
+}
+
+// newOperationError returns an error that occurs while executing an operation.
+func newOperationError(operation *Operation, err error) *OperationError {
+	return &OperationError{
+		Scope:   operation.Scope,
+		GuildID: operation.GuildID,
+		Command: operation.Key(),
+		Action:  operation.Action,
+		Err:     err,
+	}
+}
+
+// Error implements the error interface.
+func (e *OperationError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *OperationError) Unwrap() error {
+	return e.Err
+}
+
+// AggregateError represents the errors that occur during a synchronization using the ContinueOnError option.
+type AggregateError struct {
+	Errors []*OperationError
+}
+
+// newAggregateError returns an error which aggregates operation errors, or nil when there are no errors.
+func newAggregateError(errs []*OperationError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &AggregateError{Errors: errs}
+}
+
+// Error implements the error interface.
+func (e *AggregateError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+
+	return fmt.Sprintf("%d errors occurred: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the operation errors.
+func (e *AggregateError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// mergeErrors returns an error which aggregates the operation errors of two errors
+// when both errors are an *AggregateError, or joins the errors otherwise.
+func mergeErrors(x, y error) error {
+	if x == nil {
+		return y
+	}
+
+	if y == nil {
+		return x
+	}
+
+	xAggregate, xok := x.(*AggregateError)
+	yAggregate, yok := y.(*AggregateError)
+
+	if xok && yok {
+		return newAggregateError(append(slices.Clone(xAggregate.Errors), yAggregate.Errors...))
+	}
+
+	return errors.Join(x, y)
+}
@@ -0,0 +1,135 @@
+package disgoform
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+
+	"github.com/switchupcb/disgo"
+)
+
+// readyGuildIDs returns the IDs of the guilds the bot is in.
+//
+// WARNING: This function connects and disconnects from the Discord Gateway.
+// The session is disconnected when the context is canceled.
+func readyGuildIDs(ctx context.Context, bot *disgo.Client, logger *slog.Logger) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// lock represents a lock used to confirm the ready event is handled once.
+	var lock sync.Mutex
+
+	// run tracks whether a ready event has been handled (or the context is canceled).
+	run := false
+
+	// Connect to the Discord Gateway to receive a ready event which contains all of the guilds the bot is in.
+	// https://discord.com/developers/docs/events/gateway-events#ready
+	if bot.Handlers == nil {
+		bot.Handlers = new(disgo.Handlers)
+	}
+
+	if bot.Sessions == nil {
+		bot.Sessions = disgo.NewSessionManager()
+	}
+
+	// s represents a Session used to connect to the Discord Gateway.
+	s := disgo.NewSession()
+
+	// disconnect disconnects the session once (while the lock is held).
+	disconnect := func() {
+		if run {
+			return
+		}
+
+		run = true
+
+		if disconnectErr := s.Disconnect(); disconnectErr != nil {
+			logger.Error("cannot disconnect session used to discover guilds", slog.Any(logKeyError, disconnectErr))
+		}
+	}
+
+	// guildIDs represents the IDs of the guilds the bot is in.
+	var guildIDs []string
+
+	// err represents an error used to return any errors experienced while handling the ready event.
+	var err error
+
+	if e := bot.Handle(disgo.FlagGatewayEventNameReady, func(r *disgo.Ready) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if run {
+			return
+		}
+
+		defer disconnect()
+
+		for _, guild := range r.Guilds {
+			if guild == nil {
+				err = errors.New("impossible")
+
+				return
+			}
+
+			guildIDs = append(guildIDs, guild.ID)
+		}
+	}); e != nil {
+		return nil, e
+	}
+
+	connected := make(chan error, 1)
+
+	go func() {
+		connected <- s.Connect(bot)
+	}()
+
+	select {
+	case e := <-connected:
+		if e != nil {
+			return nil, e
+		}
+
+	case <-ctx.Done():
+		// disconnect the session once it connects.
+		go func() {
+			if e := <-connected; e == nil {
+				lock.Lock()
+				disconnect()
+				lock.Unlock()
+			}
+		}()
+
+		return nil, ctx.Err()
+	}
+
+	disconnected := make(chan struct{})
+
+	go func() {
+		_, _ = s.Wait()
+
+		close(disconnected)
+	}()
+
+	select {
+	case <-disconnected:
+	case <-ctx.Done():
+		lock.Lock()
+		disconnect()
+		lock.Unlock()
+
+		<-disconnected
+
+		return nil, ctx.Err()
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return guildIDs, nil
+}
@@ -3,8 +3,11 @@ module github.com/switchupcb/disgoform
 go 1.23.6
 
 require (
+	github.com/pelletier/go-toml/v2 v2.4.3
+	github.com/rs/xid v1.6.0
 	github.com/rs/zerolog v1.33.0
 	github.com/switchupcb/disgo v1.10.3-0.20250224222932-796698a76d55
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -14,7 +17,6 @@ require (
 	github.com/klauspost/compress v1.18.0 // indirect
 	github.com/mattn/go-colorable v0.1.14 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
-	github.com/rs/xid v1.6.0 // indirect
 	github.com/switchupcb/websocket v1.8.8 // indirect
 	github.com/valyala/bytebufferpool v1.0.0 // indirect
 	github.com/valyala/fasthttp v1.59.0 // indirect
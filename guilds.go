@@ -0,0 +1,152 @@
+package disgoform
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/switchupcb/disgo"
+)
+
+// maxGuildsPerPage represents the maximum amount of guilds returned by a Get Current User Guilds request.
+const maxGuildsPerPage = 200
+
+// UnreachableGuild represents a guild with defined guild application commands which the bot is not in.
+type UnreachableGuild struct {
+	// GuildID represents the ID of the guild.
+	GuildID string
+
+	// Commands represents the keys of the application commands defined for the guild.
+	Commands []CommandKey
+}
+
+// UnreachableGuildError represents an error that occurs when guild application commands are defined
+// for guilds the bot is not in.
+type UnreachableGuildError struct {
+	Guilds []UnreachableGuild
+}
+
+// Error implements the error interface.
+func (e *UnreachableGuildError) Error() string {
+	var b strings.Builder
+
+	b.WriteString("guild application commands are defined for guilds the bot is not in:")
+
+	for _, guild := range e.Guilds {
+		commands := make([]string, len(guild.Commands))
+		for i, key := range guild.Commands {
+			commands[i] = key.String()
+		}
+
+		fmt.Fprintf(&b, " guild %q (%s);", guild.GuildID, strings.Join(commands, ", "))
+	}
+
+	return strings.TrimSuffix(b.String(), ";")
+}
+
+// unreachableGuilds returns an error when guild application commands are defined for guilds
+// which are not discovered, or nil when every defined guild is discovered.
+func unreachableGuilds(guildIDs []string, definedCommandGuildIDMap map[string]map[CommandKey]disgo.CreateGuildApplicationCommand) *UnreachableGuildError {
+	var guilds []UnreachableGuild
+
+	for _, guildID := range slices.Sorted(maps.Keys(definedCommandGuildIDMap)) {
+		if slices.Contains(guildIDs, guildID) {
+			continue
+		}
+
+		guilds = append(guilds, UnreachableGuild{
+			GuildID:  guildID,
+			Commands: slices.SortedFunc(maps.Keys(definedCommandGuildIDMap[guildID]), compareCommandKeys),
+		})
+	}
+
+	if len(guilds) == 0 {
+		return nil
+	}
+
+	return &UnreachableGuildError{Guilds: guilds}
+}
+
+// managedGuildIDs returns the IDs of the discovered guilds which are managed using a policy.
+func managedGuildIDs(guildIDs []string, policy GuildPolicy, allowlist []string, definedCommandGuildIDMap map[string]map[CommandKey]disgo.CreateGuildApplicationCommand) ([]string, error) {
+	switch policy {
+	case GuildPolicyAll:
+		return guildIDs, nil
+
+	case GuildPolicyDeclared:
+		return slices.DeleteFunc(slices.Clone(guildIDs), func(guildID string) bool {
+			_, ok := definedCommandGuildIDMap[guildID]
+
+			return !ok
+		}), nil
+
+	case GuildPolicyAllowlist:
+		for _, guildID := range slices.Sorted(maps.Keys(definedCommandGuildIDMap)) {
+			if !slices.Contains(allowlist, guildID) {
+				return nil, fmt.Errorf("cannot define guild application commands for guild %q which is not in the guild allowlist", guildID)
+			}
+		}
+
+		return slices.DeleteFunc(slices.Clone(guildIDs), func(guildID string) bool {
+			return !slices.Contains(allowlist, guildID)
+		}), nil
+	}
+
+	return nil, fmt.Errorf("unknown guild policy %d", policy)
+}
+
+// discoverGuildIDs returns the IDs of the guilds which are synchronized using a discovery method.
+func discoverGuildIDs(ctx context.Context, c *Config, definedCommandGuildIDMap map[string]map[CommandKey]disgo.CreateGuildApplicationCommand) ([]string, error) {
+	switch c.GuildDiscovery {
+	case GuildDiscoveryREST:
+		return currentUserGuildIDs(ctx, c.Client)
+
+	case GuildDiscoveryDeclared:
+		return slices.Sorted(maps.Keys(definedCommandGuildIDMap)), nil
+
+	case GuildDiscoveryGateway:
+		return readyGuildIDs(ctx, c.Client, c.Logger)
+	}
+
+	return nil, fmt.Errorf("unknown guild discovery method %d", c.GuildDiscovery)
+}
+
+// currentUserGuildIDs returns the IDs of the guilds the bot is in using paginated Get Current User Guilds requests.
+//
+// https://discord.com/developers/docs/resources/user#get-current-user-guilds
+func currentUserGuildIDs(ctx context.Context, bot *disgo.Client) ([]string, error) {
+	var guildIDs []string
+
+	request := &getCurrentUserGuilds{
+		After: "",
+		Limit: maxGuildsPerPage,
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		guilds, err := request.Send(bot)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get current user guilds: %w", err)
+		}
+
+		for _, guild := range guilds {
+			if guild == nil {
+				continue
+			}
+
+			guildIDs = append(guildIDs, guild.ID)
+		}
+
+		// a page which is not full is the last page.
+		if len(guilds) < maxGuildsPerPage || len(guildIDs) == 0 {
+			return guildIDs, nil
+		}
+
+		request.After = guildIDs[len(guildIDs)-1]
+	}
+}
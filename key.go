@@ -0,0 +1,53 @@
+package disgoform
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/switchupcb/disgo"
+)
+
+// CommandKey represents the identity of an application command within a scope.
+//
+// Discord allows application commands with different types (e.g., CHAT_INPUT, USER, MESSAGE) to share a name.
+type CommandKey struct {
+	// Name represents the name of the application command.
+	Name string
+
+	// Type represents the type of the application command.
+	Type disgo.Flag
+}
+
+// commandKey returns the key of an application command with a type and name.
+func commandKey(commandType *disgo.Flag, name string) CommandKey {
+	return CommandKey{
+		Name: name,
+		Type: *normalizeType(commandType),
+	}
+}
+
+// String returns a human-readable representation of the key.
+func (k CommandKey) String() string {
+	return fmt.Sprintf("%s %q", commandTypeName(k.Type), k.Name)
+}
+
+// compareCommandKeys compares two keys by name, then type.
+func compareCommandKeys(a, b CommandKey) int {
+	return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Type, b.Type))
+}
+
+// commandTypeName returns the name of an application command type.
+func commandTypeName(commandType disgo.Flag) string {
+	switch commandType {
+	case disgo.FlagApplicationCommandTypeCHAT_INPUT:
+		return "CHAT_INPUT"
+	case disgo.FlagApplicationCommandTypeUSER:
+		return "USER"
+	case disgo.FlagApplicationCommandTypeMESSAGE:
+		return "MESSAGE"
+	case disgo.FlagApplicationCommandTypePRIMARY_ENTRY_POINT:
+		return "PRIMARY_ENTRY_POINT"
+	}
+
+	return fmt.Sprintf("TYPE(%d)", commandType)
+}
@@ -0,0 +1,1323 @@
+package disgoform
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pelletier/go-toml/v2/unstable"
+	"github.com/switchupcb/disgo"
+	"gopkg.in/yaml.v3"
+)
+
+// Definitions represents application command definitions loaded from a definition file.
+type Definitions struct {
+	GlobalApplicationCommands     []disgo.CreateGlobalApplicationCommand
+	GuildApplicationCommands      []disgo.CreateGuildApplicationCommand
+	ApplicationCommandPermissions []CommandPermissions
+	PreventDestroy                []ProtectedCommand
+}
+
+// LoadError represents an error that occurs at a position of a definition file.
+type LoadError struct {
+	// File represents the name of the definition file.
+	File string
+
+	// Line represents the line of the error (starting at 1).
+	//
+	// Line is 0 when the position of the error is unknown.
+	Line int
+
+	// Message represents the error.
+	Message string
+}
+
+// Error implements the error interface.
+func (e *LoadError) Error() string {
+	if e.Line == 0 {
+		return e.File + ": " + e.Message
+	}
+
+	return e.File + ":" + strconv.Itoa(e.Line) + ": " + e.Message
+}
+
+// LoadFile loads application command definitions from a YAML (.yaml, .yml), JSON (.json), or TOML (.toml) file.
+//
+// LoadFile returns a *LoadError (or multiple joined *LoadError) when the file does not match the definition file schema.
+// Use Validate to validate the loaded definitions against Discord limits.
+func LoadFile(name string) (*Definitions, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("LoadFile: %w", err)
+	}
+
+	definitions, _, err := load(name, data)
+	if err != nil {
+		return nil, fmt.Errorf("LoadFile: %w", err)
+	}
+
+	return definitions, nil
+}
+
+// ValidateFile loads application command definitions from a definition file (LoadFile),
+// then validates them against Discord limits (Validate).
+//
+// ValidateFile returns a *LoadError (or multiple joined *LoadError) when the file does not match the definition file schema,
+// or a *ValidationError containing every violation and its line in the definition file.
+func ValidateFile(name string, opts ...Option) (*Definitions, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("ValidateFile: %w", err)
+	}
+
+	definitions, positions, err := load(name, data)
+	if err != nil {
+		return nil, fmt.Errorf("ValidateFile: %w", err)
+	}
+
+	syncer := NewSyncer(Config{ //nolint:exhaustruct
+		GlobalApplicationCommands:     definitions.GlobalApplicationCommands,
+		GuildApplicationCommands:      definitions.GuildApplicationCommands,
+		ApplicationCommandPermissions: definitions.ApplicationCommandPermissions,
+		PreventDestroy:                definitions.PreventDestroy,
+	})
+
+	if err := syncer.Validate(opts...); err != nil {
+		var validationErr *ValidationError
+		if errors.As(err, &validationErr) {
+			for i := range validationErr.Violations {
+				validationErr.Violations[i].Line = positions.line(validationErr.Violations[i].Path)
+			}
+		}
+
+		return nil, fmt.Errorf("ValidateFile: %w", err)
+	}
+
+	return definitions, nil
+}
+
+// Load loads application command definitions from the data of a definition file.
+//
+// The extension of the name determines the format of the data: YAML (.yaml, .yml), JSON (.json), or TOML (.toml).
+func Load(name string, data []byte) (*Definitions, error) {
+	definitions, _, err := load(name, data)
+	if err != nil {
+		return nil, fmt.Errorf("Load: %w", err)
+	}
+
+	return definitions, nil
+}
+
+// load loads application command definitions from the data of a definition file,
+// and returns the positions of the definitions in the definition file.
+func load(name string, data []byte) (*Definitions, *definitionPositions, error) {
+	var (
+		file  definitionFile
+		lines map[string]int
+		err   error
+	)
+
+	switch extension := strings.ToLower(filepath.Ext(name)); extension {
+	case ".yaml", ".yml", ".json":
+		// JSON is a subset of YAML.
+		err = decodeYAML(data, &file)
+		lines = yamlLines(data)
+	case ".toml":
+		err = decodeTOML(data, &file)
+		lines = tomlLines(data)
+	default:
+		return nil, nil, fmt.Errorf("unsupported definition file extension %q", extension)
+	}
+
+	if err != nil {
+		return nil, nil, withFile(err, name)
+	}
+
+	positions := &definitionPositions{lines: lines, paths: make(map[string]string)}
+
+	definitions, err := file.definitions(positions)
+	if err != nil {
+		return nil, nil, withFile(err, name)
+	}
+
+	return definitions, positions, nil
+}
+
+// DefinitionFile returns a definition file which defines Global and Guild application commands.
+//
+// The extension of the name determines the format of the definition file: YAML (.yaml, .yml), JSON (.json), or TOML (.toml).
+// Fields which equal the default values Discord fills in are omitted.
+func DefinitionFile(name string, definitions *Definitions) ([]byte, error) {
+	file, err := newDefinitionFile(definitions)
+	if err != nil {
+		return nil, fmt.Errorf("DefinitionFile: %w", err)
+	}
+
+	var output []byte
+
+	switch extension := strings.ToLower(filepath.Ext(name)); extension {
+	case ".yaml", ".yml":
+		var b bytes.Buffer
+
+		encoder := yaml.NewEncoder(&b)
+		encoder.SetIndent(2) //nolint:mnd
+
+		if err = encoder.Encode(file); err == nil {
+			err = encoder.Close()
+		}
+
+		output = b.Bytes()
+	case ".json":
+		output, err = json.MarshalIndent(file, "", "  ")
+		output = append(output, '\n')
+	case ".toml":
+		output, err = toml.Marshal(file)
+	default:
+		return nil, fmt.Errorf("DefinitionFile: unsupported definition file extension %q", extension)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("DefinitionFile: %w", err)
+	}
+
+	return output, nil
+}
+
+// yamlErrorRegex represents the format of a YAML error message with a line.
+var yamlErrorRegex = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+// decodeYAML decodes YAML data into a definition file.
+func decodeYAML(data []byte, file *definitionFile) error {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	err := decoder.Decode(file)
+	if err == nil || errors.Is(err, io.EOF) {
+		return nil
+	}
+
+	var loadErr *LoadError
+	if errors.As(err, &loadErr) {
+		return loadErr
+	}
+
+	messages := []string{err.Error()}
+
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		messages = typeErr.Errors
+	}
+
+	errs := make([]error, len(messages))
+	for i, message := range messages {
+		errs[i] = newYAMLLoadError(message)
+	}
+
+	return errors.Join(errs...)
+}
+
+// newYAMLLoadError returns a *LoadError from a YAML error message.
+func newYAMLLoadError(message string) *LoadError {
+	loadErr := &LoadError{File: "", Line: 0, Message: strings.TrimPrefix(message, "yaml: ")}
+
+	if match := yamlErrorRegex.FindStringSubmatch(message); match != nil {
+		loadErr.Line, _ = strconv.Atoi(match[1])
+		loadErr.Message = match[2]
+	}
+
+	return loadErr
+}
+
+// decodeTOML decodes TOML data into a definition file.
+func decodeTOML(data []byte, file *definitionFile) error {
+	decoder := toml.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(file)
+	if err == nil {
+		return nil
+	}
+
+	var strictErr *toml.StrictMissingError
+	if errors.As(err, &strictErr) {
+		errs := make([]error, len(strictErr.Errors))
+		for i := range strictErr.Errors {
+			row, _ := strictErr.Errors[i].Position()
+			errs[i] = &LoadError{
+				File:    "",
+				Line:    row,
+				Message: fmt.Sprintf("field %s not found", strings.Join(strictErr.Errors[i].Key(), ".")),
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+
+	var decodeErr *toml.DecodeError
+	if errors.As(err, &decodeErr) {
+		row, _ := decodeErr.Position()
+
+		return &LoadError{File: "", Line: row, Message: strings.TrimPrefix(decodeErr.Error(), "toml: ")}
+	}
+
+	var loadErr *LoadError
+	if errors.As(err, &loadErr) {
+		return loadErr
+	}
+
+	return &LoadError{File: "", Line: 0, Message: err.Error()}
+}
+
+// withFile sets the file of every *LoadError in an error.
+func withFile(err error, name string) error {
+	var loadErr *LoadError
+	if errors.As(err, &loadErr) {
+		loadErr.File = name
+	}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok { //nolint:errorlint
+		for _, err := range joined.Unwrap() {
+			withFile(err, name)
+		}
+	}
+
+	return err
+}
+
+// definitionPositions represents the positions of application command definitions in a definition file.
+type definitionPositions struct {
+	// lines maps the paths of the values in a definition file (e.g., global[0].options[1].name) to their lines.
+	lines map[string]int
+
+	// paths maps the paths of loaded definitions (e.g., GlobalApplicationCommands[0].options[1])
+	// to the paths of their values in the definition file.
+	paths map[string]string
+}
+
+// line returns the line of a loaded definition (e.g., the path of a Violation), or 0 when the line is unknown.
+func (p *definitionPositions) line(path string) int {
+	for prefix := path; prefix != ""; prefix = parentPath(prefix) {
+		if filePath, ok := p.paths[prefix]; ok {
+			return p.fileLine(filePath + path[len(prefix):])
+		}
+	}
+
+	return 0
+}
+
+// fileLine returns the line of a value in a definition file, or the line of its closest parent.
+//
+// fileLine returns 0 when the line is unknown.
+func (p *definitionPositions) fileLine(filePath string) int {
+	for ; filePath != ""; filePath = parentPath(filePath) {
+		if line, ok := p.lines[filePath]; ok {
+			return line
+		}
+	}
+
+	return 0
+}
+
+// addOptions maps the paths of the options of a loaded definition to the paths of their option definitions.
+//
+// Nil option and choice definitions are skipped when they are loaded (optionDefinitions, choiceDefinitions).
+func (p *definitionPositions) addOptions(path, filePath string, definitions []*optionDefinition) {
+	i := 0
+
+	for j, option := range definitions {
+		if option == nil {
+			continue
+		}
+
+		optionPath := fmt.Sprintf("%s.options[%d]", path, i)
+		optionFilePath := fmt.Sprintf("%s.options[%d]", filePath, j)
+		p.paths[optionPath] = optionFilePath
+		p.addOptions(optionPath, optionFilePath, option.Options)
+
+		k := 0
+
+		for l, choice := range option.Choices {
+			if choice == nil {
+				continue
+			}
+
+			p.paths[fmt.Sprintf("%s.choices[%d]", optionPath, k)] = fmt.Sprintf("%s.choices[%d]", optionFilePath, l)
+			k++
+		}
+
+		i++
+	}
+}
+
+// addOverwrites maps the paths of the permission overwrites of loaded permissions
+// to the paths of their overwrite definitions.
+func (p *definitionPositions) addOverwrites(path, filePath string, definitions []*overwriteDefinition) {
+	i := 0
+
+	for j, overwrite := range definitions {
+		if overwrite == nil {
+			continue
+		}
+
+		p.paths[fmt.Sprintf("%s.permissions[%d]", path, i)] = fmt.Sprintf("%s.overwrites[%d]", filePath, j)
+		i++
+	}
+}
+
+// parentPath returns the path of the parent of a value (e.g., global[0] of global[0].name),
+// or an empty string when the value has no parent.
+func parentPath(path string) string {
+	i := strings.LastIndexAny(path, ".[")
+	if i < 0 {
+		return ""
+	}
+
+	return path[:i]
+}
+
+// joinPath returns the path of a key of a value.
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// yamlLines returns a map of the paths of the values of YAML data to their lines.
+func yamlLines(data []byte) map[string]int {
+	lines := make(map[string]int)
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err == nil {
+		addYAMLLines(lines, "", &node)
+	}
+
+	return lines
+}
+
+// addYAMLLines adds the lines of the values of a YAML node to a map of paths to lines.
+func addYAMLLines(lines map[string]int, path string, node *yaml.Node) {
+	switch node.Kind { //nolint:exhaustive
+	case yaml.DocumentNode:
+		for _, content := range node.Content {
+			addYAMLLines(lines, path, content)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyPath := joinPath(path, node.Content[i].Value)
+			lines[keyPath] = node.Content[i].Line
+			addYAMLLines(lines, keyPath, node.Content[i+1])
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			lines[itemPath] = item.Line
+			addYAMLLines(lines, itemPath, item)
+		}
+	}
+}
+
+// tomlLines returns a map of the paths of the values of TOML data to their lines.
+func tomlLines(data []byte) map[string]int {
+	var (
+		parser unstable.Parser
+		lines  = make(map[string]int)
+
+		// lengths maps the paths of arrays of tables to their lengths.
+		lengths = make(map[string]int)
+
+		// table represents the path of the current table.
+		table string
+	)
+
+	parser.Reset(data)
+
+	for parser.NextExpression() {
+		expression := parser.Expression()
+
+		switch expression.Kind { //nolint:exhaustive
+		case unstable.Table, unstable.ArrayTable:
+			table = ""
+
+			for key := expression.Key(); key.Next(); {
+				table = joinPath(table, string(key.Node().Data))
+
+				switch length := lengths[table]; {
+				case key.IsLast() && expression.Kind == unstable.ArrayTable:
+					lengths[table]++
+					table = fmt.Sprintf("%s[%d]", table, length)
+				case length != 0:
+					// a table header key refers to the last table of an array of tables.
+					table = fmt.Sprintf("%s[%d]", table, length-1)
+				}
+
+				lines[table] = parser.Shape(key.Node().Raw).Start.Line
+			}
+		case unstable.KeyValue:
+			addTOMLKeyValue(&parser, lines, table, expression)
+		}
+	}
+
+	return lines
+}
+
+// addTOMLKeyValue adds the lines of the values of a TOML key-value in a table to a map of paths to lines.
+func addTOMLKeyValue(parser *unstable.Parser, lines map[string]int, table string, keyValue *unstable.Node) {
+	path := table
+
+	for key := keyValue.Key(); key.Next(); {
+		path = joinPath(path, string(key.Node().Data))
+		lines[path] = parser.Shape(key.Node().Raw).Start.Line
+	}
+
+	addTOMLValue(parser, lines, path, keyValue.Value())
+}
+
+// addTOMLValue adds the lines of the elements of a TOML array or inline table to a map of paths to lines.
+func addTOMLValue(parser *unstable.Parser, lines map[string]int, path string, value *unstable.Node) {
+	switch value.Kind { //nolint:exhaustive
+	case unstable.InlineTable:
+		for child := value.Children(); child.Next(); {
+			addTOMLKeyValue(parser, lines, path, child.Node())
+		}
+	case unstable.Array:
+		i := 0
+
+		for child := value.Children(); child.Next(); i++ {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			if child.Node().Raw.Length != 0 {
+				lines[itemPath] = parser.Shape(child.Node().Raw).Start.Line
+			}
+
+			addTOMLValue(parser, lines, itemPath, child.Node())
+		}
+	}
+}
+
+// definitionFile represents the schema of a definition file.
+//
+// The JSON Schema of a definition file is located at disgoform.schema.json.
+type definitionFile struct {
+	// Schema represents the JSON Schema of the definition file (for editors).
+	Schema string `json:"$schema,omitempty" toml:"$schema,omitempty" yaml:"$schema,omitempty"`
+
+	Global []*globalCommandDefinition `json:"global,omitempty" toml:"global,omitempty" yaml:"global,omitempty"`
+	Guilds []*guildDefinition         `json:"guilds,omitempty" toml:"guilds,omitempty" yaml:"guilds,omitempty"`
+}
+
+// guildDefinition represents the application commands of a guild in a definition file.
+type guildDefinition struct {
+	GuildID     scalar                   `json:"guild_id,omitempty" toml:"guild_id,omitempty" yaml:"guild_id,omitempty"`
+	Commands    []*commandDefinition     `json:"commands,omitempty" toml:"commands,omitempty" yaml:"commands,omitempty"`
+	Permissions []*permissionsDefinition `json:"permissions,omitempty" toml:"permissions,omitempty" yaml:"permissions,omitempty"`
+}
+
+// permissionsDefinition represents the permission overwrites of an application command in a definition file.
+type permissionsDefinition struct {
+	// Command represents the name of the application command (empty for the application-wide permission overwrites).
+	Command    string                 `json:"command,omitempty" toml:"command,omitempty" yaml:"command,omitempty"`
+	Type       *commandType           `json:"type,omitempty" toml:"type,omitempty" yaml:"type,omitempty"`
+	Overwrites []*overwriteDefinition `json:"overwrites" toml:"overwrites" yaml:"overwrites"`
+}
+
+// overwriteDefinition represents a permission overwrite in a definition file.
+//
+// The ID of a ROLE overwrite can be EVERYONE (@everyone) and the ID of a CHANNEL overwrite can be ALL_CHANNELS.
+type overwriteDefinition struct {
+	Type       permissionType `json:"type" toml:"type" yaml:"type"`
+	ID         scalar         `json:"id" toml:"id" yaml:"id"`
+	Permission bool           `json:"permission" toml:"permission" yaml:"permission"`
+}
+
+// Permission overwrite ID aliases in a definition file.
+const (
+	everyoneAlias    = "EVERYONE"
+	allChannelsAlias = "ALL_CHANNELS"
+)
+
+// commandDefinition represents an application command in a definition file.
+type commandDefinition struct {
+	Name                     string              `json:"name,omitempty" toml:"name,omitempty" yaml:"name,omitempty"`
+	NameLocalizations        map[string]string   `json:"name_localizations,omitempty" toml:"name_localizations,omitempty" yaml:"name_localizations,omitempty"`
+	Type                     *commandType        `json:"type,omitempty" toml:"type,omitempty" yaml:"type,omitempty"`
+	Description              *string             `json:"description,omitempty" toml:"description,omitempty" yaml:"description,omitempty"`
+	DescriptionLocalizations map[string]string   `json:"description_localizations,omitempty" toml:"description_localizations,omitempty" yaml:"description_localizations,omitempty"`
+	Options                  []*optionDefinition `json:"options,omitempty" toml:"options,omitempty" yaml:"options,omitempty"`
+	DefaultMemberPermissions *scalar             `json:"default_member_permissions,omitempty" toml:"default_member_permissions,omitempty" yaml:"default_member_permissions,omitempty"`
+	NSFW                     *bool               `json:"nsfw,omitempty" toml:"nsfw,omitempty" yaml:"nsfw,omitempty"`
+	PreventDestroy           bool                `json:"prevent_destroy,omitempty" toml:"prevent_destroy,omitempty" yaml:"prevent_destroy,omitempty"`
+}
+
+// globalCommandDefinition represents a global application command in a definition file.
+type globalCommandDefinition struct {
+	commandDefinition `yaml:",inline"`
+
+	IntegrationTypes []integrationType `json:"integration_types,omitempty" toml:"integration_types,omitempty" yaml:"integration_types,omitempty"`
+	Contexts         []contextType     `json:"contexts,omitempty" toml:"contexts,omitempty" yaml:"contexts,omitempty"`
+}
+
+// optionDefinition represents an application command option in a definition file.
+type optionDefinition struct {
+	Type                     optionType          `json:"type,omitempty" toml:"type,omitempty" yaml:"type,omitempty"`
+	Name                     string              `json:"name,omitempty" toml:"name,omitempty" yaml:"name,omitempty"`
+	NameLocalizations        map[string]string   `json:"name_localizations,omitempty" toml:"name_localizations,omitempty" yaml:"name_localizations,omitempty"`
+	Description              string              `json:"description,omitempty" toml:"description,omitempty" yaml:"description,omitempty"`
+	DescriptionLocalizations map[string]string   `json:"description_localizations,omitempty" toml:"description_localizations,omitempty" yaml:"description_localizations,omitempty"`
+	Required                 *bool               `json:"required,omitempty" toml:"required,omitempty" yaml:"required,omitempty"`
+	Choices                  []*choiceDefinition `json:"choices,omitempty" toml:"choices,omitempty" yaml:"choices,omitempty"`
+	Options                  []*optionDefinition `json:"options,omitempty" toml:"options,omitempty" yaml:"options,omitempty"`
+	ChannelTypes             []channelType       `json:"channel_types,omitempty" toml:"channel_types,omitempty" yaml:"channel_types,omitempty"`
+	MinValue                 *float64            `json:"min_value,omitempty" toml:"min_value,omitempty" yaml:"min_value,omitempty"`
+	MaxValue                 *float64            `json:"max_value,omitempty" toml:"max_value,omitempty" yaml:"max_value,omitempty"`
+	MinLength                *int                `json:"min_length,omitempty" toml:"min_length,omitempty" yaml:"min_length,omitempty"`
+	MaxLength                *int                `json:"max_length,omitempty" toml:"max_length,omitempty" yaml:"max_length,omitempty"`
+	Autocomplete             *bool               `json:"autocomplete,omitempty" toml:"autocomplete,omitempty" yaml:"autocomplete,omitempty"`
+}
+
+// choiceDefinition represents an application command option choice in a definition file.
+type choiceDefinition struct {
+	Name              string            `json:"name,omitempty" toml:"name,omitempty" yaml:"name,omitempty"`
+	NameLocalizations map[string]string `json:"name_localizations,omitempty" toml:"name_localizations,omitempty" yaml:"name_localizations,omitempty"`
+	Value             scalar            `json:"value,omitempty" toml:"value,omitempty" yaml:"value,omitempty"`
+}
+
+// definitions returns the application command definitions of a definition file,
+// and maps the paths of the definitions to the paths of their values in the definition file (positions).
+func (f *definitionFile) definitions(positions *definitionPositions) (*Definitions, error) {
+	definitions := &Definitions{
+		GlobalApplicationCommands:     make([]disgo.CreateGlobalApplicationCommand, 0, len(f.Global)),
+		GuildApplicationCommands:      nil,
+		ApplicationCommandPermissions: nil,
+		PreventDestroy:                nil,
+	}
+
+	positions.paths["GlobalApplicationCommands"] = "global"
+
+	for i, command := range f.Global {
+		if command == nil {
+			continue
+		}
+
+		filePath := fmt.Sprintf("global[%d]", i)
+
+		if command.PreventDestroy {
+			positions.paths[fmt.Sprintf("PreventDestroy[%d]", len(definitions.PreventDestroy))] = filePath
+			definitions.PreventDestroy = append(definitions.PreventDestroy, ProtectedCommand{
+				GuildID: "",
+				Name:    command.Name,
+				Type:    (*disgo.Flag)(command.Type),
+			})
+		}
+
+		path := fmt.Sprintf("GlobalApplicationCommands[%d]", len(definitions.GlobalApplicationCommands))
+		positions.paths[path] = filePath
+		positions.addOptions(path, filePath, command.Options)
+
+		definitions.GlobalApplicationCommands = append(definitions.GlobalApplicationCommands, disgo.CreateGlobalApplicationCommand{
+			Name:                     command.Name,
+			NameLocalizations:        localizations(command.NameLocalizations),
+			Description:              command.Description,
+			DescriptionLocalizations: localizations(command.DescriptionLocalizations),
+			Options:                  optionDefinitions(command.Options),
+			DefaultMemberPermissions: permissions(command.DefaultMemberPermissions),
+			IntegrationTypes:         flags(command.IntegrationTypes),
+			Contexts:                 flags(command.Contexts),
+			Type:                     (*disgo.Flag)(command.Type),
+			NSFW:                     command.NSFW,
+		})
+	}
+
+	for i, guild := range f.Guilds {
+		if guild == nil {
+			continue
+		}
+
+		for j, command := range guild.Commands {
+			if command == nil {
+				continue
+			}
+
+			filePath := fmt.Sprintf("guilds[%d].commands[%d]", i, j)
+
+			if command.PreventDestroy {
+				positions.paths[fmt.Sprintf("PreventDestroy[%d]", len(definitions.PreventDestroy))] = filePath
+				definitions.PreventDestroy = append(definitions.PreventDestroy, ProtectedCommand{
+					GuildID: string(guild.GuildID),
+					Name:    command.Name,
+					Type:    (*disgo.Flag)(command.Type),
+				})
+			}
+
+			path := fmt.Sprintf("GuildApplicationCommands[%d]", len(definitions.GuildApplicationCommands))
+			positions.paths[path] = filePath
+			positions.addOptions(path, filePath, command.Options)
+
+			definitions.GuildApplicationCommands = append(definitions.GuildApplicationCommands, disgo.CreateGuildApplicationCommand{
+				GuildID:                  string(guild.GuildID),
+				Name:                     command.Name,
+				NameLocalizations:        localizations(command.NameLocalizations),
+				Description:              command.Description,
+				DescriptionLocalizations: localizations(command.DescriptionLocalizations),
+				Options:                  optionDefinitions(command.Options),
+				DefaultMemberPermissions: permissions(command.DefaultMemberPermissions),
+				Type:                     (*disgo.Flag)(command.Type),
+				NSFW:                     command.NSFW,
+			})
+		}
+
+		for j, permissions := range guild.Permissions {
+			if permissions == nil {
+				continue
+			}
+
+			filePath := fmt.Sprintf("guilds[%d].permissions[%d]", i, j)
+
+			commandPermissions, err := permissions.commandPermissions(string(guild.GuildID), positions, filePath)
+			if err != nil {
+				return nil, err
+			}
+
+			path := fmt.Sprintf("ApplicationCommandPermissions[%d]", len(definitions.ApplicationCommandPermissions))
+			positions.paths[path] = filePath
+			positions.addOverwrites(path, filePath, permissions.Overwrites)
+
+			definitions.ApplicationCommandPermissions = append(definitions.ApplicationCommandPermissions, *commandPermissions)
+		}
+	}
+
+	return definitions, nil
+}
+
+// commandPermissions returns the permission overwrites of an application command in a guild.
+//
+// commandPermissions returns a *LoadError at the position of an overwrite ID alias which is invalid.
+func (d *permissionsDefinition) commandPermissions(
+	guildID string, positions *definitionPositions, filePath string,
+) (*CommandPermissions, error) {
+	permissions := &CommandPermissions{
+		GuildID:     guildID,
+		Name:        d.Command,
+		Type:        (*disgo.Flag)(d.Type),
+		Permissions: make([]*disgo.ApplicationCommandPermissions, 0, len(d.Overwrites)),
+	}
+
+	for i, overwrite := range d.Overwrites {
+		if overwrite == nil {
+			continue
+		}
+
+		id := string(overwrite.ID)
+
+		switch id {
+		case everyoneAlias:
+			id = EveryonePermissionID(guildID)
+		case allChannelsAlias:
+			var err error
+			if id, err = AllChannelsPermissionID(guildID); err != nil {
+				return nil, &LoadError{
+					File:    "",
+					Line:    positions.fileLine(fmt.Sprintf("%s.overwrites[%d].id", filePath, i)),
+					Message: fmt.Sprintf("guild %q: %s: %v", guildID, allChannelsAlias, err),
+				}
+			}
+		}
+
+		permissions.Permissions = append(permissions.Permissions, &disgo.ApplicationCommandPermissions{
+			ID:         id,
+			Type:       disgo.Flag(overwrite.Type),
+			Permission: overwrite.Permission,
+		})
+	}
+
+	return permissions, nil
+}
+
+// optionDefinitions returns the application command options of option definitions.
+func optionDefinitions(definitions []*optionDefinition) []*disgo.ApplicationCommandOption {
+	if len(definitions) == 0 {
+		return nil
+	}
+
+	options := make([]*disgo.ApplicationCommandOption, 0, len(definitions))
+	for _, option := range definitions {
+		if option == nil {
+			continue
+		}
+
+		options = append(options, &disgo.ApplicationCommandOption{
+			Type:                     disgo.Flag(option.Type),
+			Name:                     option.Name,
+			NameLocalizations:        localizations(option.NameLocalizations),
+			Description:              option.Description,
+			DescriptionLocalizations: localizations(option.DescriptionLocalizations),
+			Required:                 option.Required,
+			Choices:                  choiceDefinitions(option.Choices),
+			Options:                  optionDefinitions(option.Options),
+			ChannelTypes:             flags(option.ChannelTypes),
+			MinValue:                 option.MinValue,
+			MaxValue:                 option.MaxValue,
+			MinLength:                option.MinLength,
+			MaxLength:                option.MaxLength,
+			Autocomplete:             option.Autocomplete,
+		})
+	}
+
+	return options
+}
+
+// choiceDefinitions returns the application command option choices of choice definitions.
+func choiceDefinitions(definitions []*choiceDefinition) []*disgo.ApplicationCommandOptionChoice {
+	if len(definitions) == 0 {
+		return nil
+	}
+
+	choices := make([]*disgo.ApplicationCommandOptionChoice, 0, len(definitions))
+	for _, choice := range definitions {
+		if choice == nil {
+			continue
+		}
+
+		choices = append(choices, &disgo.ApplicationCommandOptionChoice{
+			Name:              choice.Name,
+			NameLocalizations: localizations(choice.NameLocalizations),
+			Value:             disgo.Value(choice.Value),
+		})
+	}
+
+	return choices
+}
+
+// localizations returns a localization map, or nil when there are no localizations.
+func localizations(m map[string]string) *map[string]string {
+	if len(m) == 0 {
+		return nil
+	}
+
+	return &m
+}
+
+// permissions returns the default member permissions of an application command.
+func permissions(permissions *scalar) **string {
+	if permissions == nil {
+		return nil
+	}
+
+	return disgo.Pointer2(string(*permissions))
+}
+
+// newDefinitionFile returns the definition file of application command definitions.
+func newDefinitionFile(definitions *Definitions) (*definitionFile, error) {
+	file := &definitionFile{
+		Schema: "",
+		Global: make([]*globalCommandDefinition, 0, len(definitions.GlobalApplicationCommands)),
+		Guilds: nil,
+	}
+
+	for _, command := range definitions.GlobalApplicationCommands {
+		command = trimGlobalApplicationCommand(command)
+
+		definition, err := newCommandDefinition(command.Name, command.NameLocalizations, command.Type,
+			command.Description, command.DescriptionLocalizations, command.Options, command.DefaultMemberPermissions, command.NSFW)
+		if err != nil {
+			return nil, fmt.Errorf("global application command %q: %w", command.Name, err)
+		}
+
+		definition.PreventDestroy = protected(definitions.PreventDestroy, "", command.Type, command.Name)
+
+		global := &globalCommandDefinition{
+			commandDefinition: *definition,
+			IntegrationTypes:  nil,
+			Contexts:          nil,
+		}
+
+		if global.IntegrationTypes, err = definitionFlags[integrationType](command.IntegrationTypes, integrationTypes); err != nil {
+			return nil, fmt.Errorf("global application command %q: %w", command.Name, err)
+		}
+
+		if global.Contexts, err = definitionFlags[contextType](command.Contexts, contextTypes); err != nil {
+			return nil, fmt.Errorf("global application command %q: %w", command.Name, err)
+		}
+
+		file.Global = append(file.Global, global)
+	}
+
+	guilds := make(map[string]*guildDefinition)
+
+	for _, command := range definitions.GuildApplicationCommands {
+		command = trimGuildApplicationCommand(command)
+
+		definition, err := newCommandDefinition(command.Name, command.NameLocalizations, command.Type,
+			command.Description, command.DescriptionLocalizations, command.Options, command.DefaultMemberPermissions, command.NSFW)
+		if err != nil {
+			return nil, fmt.Errorf("guild %q application command %q: %w", command.GuildID, command.Name, err)
+		}
+
+		definition.PreventDestroy = protected(definitions.PreventDestroy, command.GuildID, command.Type, command.Name)
+
+		guild, ok := guilds[command.GuildID]
+		if !ok {
+			guild = &guildDefinition{GuildID: scalar(command.GuildID), Commands: nil, Permissions: nil}
+			guilds[command.GuildID] = guild
+			file.Guilds = append(file.Guilds, guild)
+		}
+
+		guild.Commands = append(guild.Commands, definition)
+	}
+
+	for _, permissions := range definitions.ApplicationCommandPermissions {
+		guild, ok := guilds[permissions.GuildID]
+		if !ok {
+			guild = &guildDefinition{GuildID: scalar(permissions.GuildID), Commands: nil, Permissions: nil}
+			guilds[permissions.GuildID] = guild
+			file.Guilds = append(file.Guilds, guild)
+		}
+
+		definition, err := newPermissionsDefinition(permissions)
+		if err != nil {
+			return nil, fmt.Errorf("guild %q permissions of application command %q: %w", permissions.GuildID, permissions.Name, err)
+		}
+
+		guild.Permissions = append(guild.Permissions, definition)
+	}
+
+	return file, nil
+}
+
+// newPermissionsDefinition returns the definition of the permission overwrites of an application command.
+func newPermissionsDefinition(permissions CommandPermissions) (*permissionsDefinition, error) {
+	definition := &permissionsDefinition{
+		Command:    permissions.Name,
+		Type:       nil,
+		Overwrites: make([]*overwriteDefinition, 0, len(permissions.Permissions)),
+	}
+
+	if permissions.Type != nil && *permissions.Type != disgo.FlagApplicationCommandTypeCHAT_INPUT {
+		definition.Type = (*commandType)(permissions.Type)
+	}
+
+	allChannelsID, _ := AllChannelsPermissionID(permissions.GuildID)
+
+	for _, permission := range permissions.Permissions {
+		if permission == nil {
+			continue
+		}
+
+		if _, err := marshalFlag(permission.Type, permissionTypes); err != nil {
+			return nil, err
+		}
+
+		id := permission.ID
+
+		switch {
+		case permission.Type == disgo.FlagApplicationCommandPermissionTypeROLE && id == EveryonePermissionID(permissions.GuildID):
+			id = everyoneAlias
+		case permission.Type == disgo.FlagApplicationCommandPermissionTypeCHANNEL && id == allChannelsID:
+			id = allChannelsAlias
+		}
+
+		definition.Overwrites = append(definition.Overwrites, &overwriteDefinition{
+			Type:       permissionType(permission.Type),
+			ID:         scalar(id),
+			Permission: permission.Permission,
+		})
+	}
+
+	return definition, nil
+}
+
+// newCommandDefinition returns the definition of an application command.
+func newCommandDefinition(
+	name string,
+	nameLocalizations *map[string]string,
+	typ *disgo.Flag,
+	description *string,
+	descriptionLocalizations *map[string]string,
+	options []*disgo.ApplicationCommandOption,
+	defaultMemberPermissions **string,
+	nsfw *bool,
+) (*commandDefinition, error) {
+	definition := &commandDefinition{
+		Name:                     name,
+		NameLocalizations:        nil,
+		Type:                     nil,
+		Description:              description,
+		DescriptionLocalizations: nil,
+		Options:                  nil,
+		DefaultMemberPermissions: nil,
+		NSFW:                     nsfw,
+		PreventDestroy:           false,
+	}
+
+	if nameLocalizations != nil {
+		definition.NameLocalizations = *nameLocalizations
+	}
+
+	if descriptionLocalizations != nil {
+		definition.DescriptionLocalizations = *descriptionLocalizations
+	}
+
+	// CHAT_INPUT is the default application command type.
+	if typ != nil && *typ != disgo.FlagApplicationCommandTypeCHAT_INPUT {
+		if _, err := marshalFlag(*typ, commandTypes); err != nil {
+			return nil, err
+		}
+
+		definition.Type = (*commandType)(typ)
+	}
+
+	if defaultMemberPermissions != nil && *defaultMemberPermissions != nil {
+		definition.DefaultMemberPermissions = (*scalar)(*defaultMemberPermissions)
+	}
+
+	var err error
+	if definition.Options, err = newOptionDefinitions(options); err != nil {
+		return nil, err
+	}
+
+	return definition, nil
+}
+
+// newOptionDefinitions returns the definitions of application command options.
+func newOptionDefinitions(options []*disgo.ApplicationCommandOption) ([]*optionDefinition, error) {
+	if len(options) == 0 {
+		return nil, nil
+	}
+
+	definitions := make([]*optionDefinition, 0, len(options))
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+
+		if _, err := marshalFlag(option.Type, optionTypes); err != nil {
+			return nil, fmt.Errorf("option %q: %w", option.Name, err)
+		}
+
+		definition := &optionDefinition{
+			Type:                     optionType(option.Type),
+			Name:                     option.Name,
+			NameLocalizations:        nil,
+			Description:              option.Description,
+			DescriptionLocalizations: nil,
+			Required:                 option.Required,
+			Choices:                  nil,
+			Options:                  nil,
+			ChannelTypes:             nil,
+			MinValue:                 option.MinValue,
+			MaxValue:                 option.MaxValue,
+			MinLength:                option.MinLength,
+			MaxLength:                option.MaxLength,
+			Autocomplete:             option.Autocomplete,
+		}
+
+		if option.NameLocalizations != nil {
+			definition.NameLocalizations = *option.NameLocalizations
+		}
+
+		if option.DescriptionLocalizations != nil {
+			definition.DescriptionLocalizations = *option.DescriptionLocalizations
+		}
+
+		for _, choice := range option.Choices {
+			if choice == nil {
+				continue
+			}
+
+			choiceDefinition := &choiceDefinition{
+				Name:              choice.Name,
+				NameLocalizations: nil,
+				Value:             scalar(choice.Value),
+			}
+
+			if choice.NameLocalizations != nil {
+				choiceDefinition.NameLocalizations = *choice.NameLocalizations
+			}
+
+			definition.Choices = append(definition.Choices, choiceDefinition)
+		}
+
+		var err error
+		if definition.ChannelTypes, err = definitionFlags[channelType](option.ChannelTypes, channelTypes); err != nil {
+			return nil, fmt.Errorf("option %q: %w", option.Name, err)
+		}
+
+		if definition.Options, err = newOptionDefinitions(option.Options); err != nil {
+			return nil, fmt.Errorf("option %q: %w", option.Name, err)
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	return definitions, nil
+}
+
+// definitionFlags returns the definition file enumeration of flags.
+func definitionFlags[T ~uint8](flags []disgo.Flag, names map[string]disgo.Flag) ([]T, error) {
+	if flags == nil {
+		return nil, nil
+	}
+
+	values := make([]T, len(flags))
+	for i, flag := range flags {
+		if _, err := marshalFlag(flag, names); err != nil {
+			return nil, err
+		}
+
+		values[i] = T(flag)
+	}
+
+	return values, nil
+}
+
+// flags returns the flags of a definition file enumeration.
+func flags[T ~uint8](values []T) []disgo.Flag {
+	if values == nil {
+		return nil
+	}
+
+	flags := make([]disgo.Flag, len(values))
+	for i, value := range values {
+		flags[i] = disgo.Flag(value)
+	}
+
+	return flags
+}
+
+// scalar represents a string or number in a definition file (e.g., a snowflake).
+type scalar string
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (s scalar) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *scalar) UnmarshalText(text []byte) error {
+	*s = scalar(text)
+
+	return nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (s *scalar) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.ScalarNode || node.Tag == "!!null" {
+		return &LoadError{File: "", Line: node.Line, Message: "expected a string or number"}
+	}
+
+	*s = scalar(node.Value)
+
+	return nil
+}
+
+// commandTypes represents the names of application command types in a definition file.
+var commandTypes = map[string]disgo.Flag{
+	"CHAT_INPUT":          disgo.FlagApplicationCommandTypeCHAT_INPUT,
+	"USER":                disgo.FlagApplicationCommandTypeUSER,
+	"MESSAGE":             disgo.FlagApplicationCommandTypeMESSAGE,
+	"PRIMARY_ENTRY_POINT": disgo.FlagApplicationCommandTypePRIMARY_ENTRY_POINT,
+}
+
+// optionTypes represents the names of application command option types in a definition file.
+var optionTypes = map[string]disgo.Flag{
+	"SUB_COMMAND":       disgo.FlagApplicationCommandOptionTypeSUB_COMMAND,
+	"SUB_COMMAND_GROUP": disgo.FlagApplicationCommandOptionTypeSUB_COMMAND_GROUP,
+	"STRING":            disgo.FlagApplicationCommandOptionTypeSTRING,
+	"INTEGER":           disgo.FlagApplicationCommandOptionTypeINTEGER,
+	"BOOLEAN":           disgo.FlagApplicationCommandOptionTypeBOOLEAN,
+	"USER":              disgo.FlagApplicationCommandOptionTypeUSER,
+	"CHANNEL":           disgo.FlagApplicationCommandOptionTypeCHANNEL,
+	"ROLE":              disgo.FlagApplicationCommandOptionTypeROLE,
+	"MENTIONABLE":       disgo.FlagApplicationCommandOptionTypeMENTIONABLE,
+	"NUMBER":            disgo.FlagApplicationCommandOptionTypeNUMBER,
+	"ATTACHMENT":        disgo.FlagApplicationCommandOptionTypeATTACHMENT,
+}
+
+// channelTypes represents the names of channel types in a definition file.
+var channelTypes = map[string]disgo.Flag{
+	"GUILD_TEXT":          disgo.FlagChannelTypeGUILD_TEXT,
+	"DM":                  disgo.FlagChannelTypeDM,
+	"GUILD_VOICE":         disgo.FlagChannelTypeGUILD_VOICE,
+	"GROUP_DM":            disgo.FlagChannelTypeGROUP_DM,
+	"GUILD_CATEGORY":      disgo.FlagChannelTypeGUILD_CATEGORY,
+	"GUILD_ANNOUNCEMENT":  disgo.FlagChannelTypeGUILD_ANNOUNCEMENT,
+	"ANNOUNCEMENT_THREAD": disgo.FlagChannelTypeANNOUNCEMENT_THREAD,
+	"PUBLIC_THREAD":       disgo.FlagChannelTypePUBLIC_THREAD,
+	"PRIVATE_THREAD":      disgo.FlagChannelTypePRIVATE_THREAD,
+	"GUILD_STAGE_VOICE":   disgo.FlagChannelTypeGUILD_STAGE_VOICE,
+	"GUILD_DIRECTORY":     disgo.FlagChannelTypeGUILD_DIRECTORY,
+	"GUILD_FORUM":         disgo.FlagChannelTypeGUILD_FORUM,
+	"GUILD_MEDIA":         disgo.FlagChannelTypeGUILD_MEDIA,
+}
+
+// integrationTypes represents the names of application integration types in a definition file.
+var integrationTypes = map[string]disgo.Flag{
+	"GUILD_INSTALL": disgo.FlagApplicationIntegrationTypeGUILD_INSTALL,
+	"USER_INSTALL":  disgo.FlagApplicationIntegrationTypeUSER_INSTALL,
+}
+
+// contextTypes represents the names of interaction context types in a definition file.
+var contextTypes = map[string]disgo.Flag{
+	"GUILD":           disgo.FlagInteractionContextTypeGUILD,
+	"BOT_DM":          disgo.FlagInteractionContextTypeBOT_DM,
+	"PRIVATE_CHANNEL": disgo.FlagInteractionContextTypePRIVATE_CHANNEL,
+}
+
+// permissionTypes represents the names of application command permission types in a definition file.
+var permissionTypes = map[string]disgo.Flag{
+	"ROLE":    disgo.FlagApplicationCommandPermissionTypeROLE,
+	"USER":    disgo.FlagApplicationCommandPermissionTypeUSER,
+	"CHANNEL": disgo.FlagApplicationCommandPermissionTypeCHANNEL,
+}
+
+// commandType represents an application command type in a definition file.
+type commandType disgo.Flag
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t commandType) MarshalText() ([]byte, error) {
+	return marshalFlag(disgo.Flag(t), commandTypes)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *commandType) UnmarshalText(text []byte) error {
+	return unmarshalFlag((*disgo.Flag)(t), text, "application command type", commandTypes)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (t *commandType) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLFlag(node, t.UnmarshalText)
+}
+
+// optionType represents an application command option type in a definition file.
+type optionType disgo.Flag
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t optionType) MarshalText() ([]byte, error) {
+	return marshalFlag(disgo.Flag(t), optionTypes)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *optionType) UnmarshalText(text []byte) error {
+	return unmarshalFlag((*disgo.Flag)(t), text, "application command option type", optionTypes)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (t *optionType) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLFlag(node, t.UnmarshalText)
+}
+
+// channelType represents a channel type in a definition file.
+type channelType disgo.Flag
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t channelType) MarshalText() ([]byte, error) {
+	return marshalFlag(disgo.Flag(t), channelTypes)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *channelType) UnmarshalText(text []byte) error {
+	return unmarshalFlag((*disgo.Flag)(t), text, "channel type", channelTypes)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (t *channelType) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLFlag(node, t.UnmarshalText)
+}
+
+// integrationType represents an application integration type in a definition file.
+type integrationType disgo.Flag
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t integrationType) MarshalText() ([]byte, error) {
+	return marshalFlag(disgo.Flag(t), integrationTypes)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *integrationType) UnmarshalText(text []byte) error {
+	return unmarshalFlag((*disgo.Flag)(t), text, "integration type", integrationTypes)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (t *integrationType) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLFlag(node, t.UnmarshalText)
+}
+
+// contextType represents an interaction context type in a definition file.
+type contextType disgo.Flag
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t contextType) MarshalText() ([]byte, error) {
+	return marshalFlag(disgo.Flag(t), contextTypes)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *contextType) UnmarshalText(text []byte) error {
+	return unmarshalFlag((*disgo.Flag)(t), text, "interaction context type", contextTypes)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (t *contextType) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLFlag(node, t.UnmarshalText)
+}
+
+// permissionType represents an application command permission type in a definition file.
+type permissionType disgo.Flag
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t permissionType) MarshalText() ([]byte, error) {
+	return marshalFlag(disgo.Flag(t), permissionTypes)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *permissionType) UnmarshalText(text []byte) error {
+	return unmarshalFlag((*disgo.Flag)(t), text, "application command permission type", permissionTypes)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (t *permissionType) UnmarshalYAML(node *yaml.Node) error {
+	return unmarshalYAMLFlag(node, t.UnmarshalText)
+}
+
+// marshalFlag marshals the name of a flag.
+func marshalFlag(flag disgo.Flag, names map[string]disgo.Flag) ([]byte, error) {
+	for name, value := range names {
+		if value == flag {
+			return []byte(name), nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown flag %d", flag)
+}
+
+// unmarshalFlag unmarshals the name of a flag.
+func unmarshalFlag(flag *disgo.Flag, text []byte, kind string, names map[string]disgo.Flag) error {
+	value, ok := names[string(text)]
+	if !ok {
+		return fmt.Errorf("unknown %s %q", kind, text)
+	}
+
+	*flag = value
+
+	return nil
+}
+
+// unmarshalYAMLFlag unmarshals the name of a flag from a YAML node.
+func unmarshalYAMLFlag(node *yaml.Node, unmarshalText func(text []byte) error) error {
+	if node.Kind != yaml.ScalarNode {
+		return &LoadError{File: "", Line: node.Line, Message: "expected a name"}
+	}
+
+	if err := unmarshalText([]byte(node.Value)); err != nil {
+		return &LoadError{File: "", Line: node.Line, Message: err.Error()}
+	}
+
+	return nil
+}
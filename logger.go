@@ -0,0 +1,199 @@
+package disgoform
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/switchupcb/disgo"
+)
+
+// Log Attribute Keys.
+const (
+	logKeyScope     = "scope"
+	logKeyGuildID   = "guild_id"
+	logKeyCommand   = "command"
+	logKeyCommandID = "command_id"
+	logKeyOperation = "op"
+	logKeyDuration  = "duration"
+	logKeyDiff      = "diff"
+	logKeyCount     = "count"
+	logKeyError     = "error"
+)
+
+// ZerologHandler represents a slog.Handler which writes records to a zerolog.Logger.
+type ZerologHandler struct {
+	logger zerolog.Logger
+	attrs  []slog.Attr
+	group  string
+}
+
+// NewZerologHandler returns a slog.Handler which writes records to a zerolog.Logger (e.g., disgo.Logger).
+func NewZerologHandler(logger zerolog.Logger) *ZerologHandler {
+	return &ZerologHandler{
+		logger: logger,
+		attrs:  nil,
+		group:  "",
+	}
+}
+
+// Enabled implements the slog.Handler interface.
+func (h *ZerologHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return zerologLevel(level) >= h.logger.GetLevel() && zerologLevel(level) >= zerolog.GlobalLevel()
+}
+
+// Handle implements the slog.Handler interface.
+func (h *ZerologHandler) Handle(_ context.Context, record slog.Record) error {
+	event := h.logger.WithLevel(zerologLevel(record.Level))
+	if event == nil {
+		return nil
+	}
+
+	for _, attr := range h.attrs {
+		event = zerologAttr(event, "", attr)
+	}
+
+	record.Attrs(func(attr slog.Attr) bool {
+		event = zerologAttr(event, h.group, attr)
+
+		return true
+	})
+
+	event.Msg(record.Message)
+
+	return nil
+}
+
+// WithAttrs implements the slog.Handler interface.
+func (h *ZerologHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handler := *h
+	handler.attrs = make([]slog.Attr, 0, len(h.attrs)+len(attrs))
+	handler.attrs = append(handler.attrs, h.attrs...)
+
+	for _, attr := range attrs {
+		if h.group != "" {
+			attr.Key = h.group + "." + attr.Key
+		}
+
+		handler.attrs = append(handler.attrs, attr)
+	}
+
+	return &handler
+}
+
+// WithGroup implements the slog.Handler interface.
+func (h *ZerologHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	handler := *h
+	handler.group = join(h.group, name)
+
+	return &handler
+}
+
+// zerologLevel returns the zerolog level of a slog level.
+func zerologLevel(level slog.Level) zerolog.Level {
+	switch {
+	case level >= slog.LevelError:
+		return zerolog.ErrorLevel
+	case level >= slog.LevelWarn:
+		return zerolog.WarnLevel
+	case level >= slog.LevelInfo:
+		return zerolog.InfoLevel
+	}
+
+	return zerolog.DebugLevel
+}
+
+// zerologAttr adds a slog attribute to a zerolog event.
+func zerologAttr(event *zerolog.Event, group string, attr slog.Attr) *zerolog.Event {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) { //nolint:exhaustruct
+		return event
+	}
+
+	key := join(group, attr.Key)
+
+	switch attr.Value.Kind() { //nolint:exhaustive
+	case slog.KindGroup:
+		for _, groupAttr := range attr.Value.Group() {
+			event = zerologAttr(event, key, groupAttr)
+		}
+
+		return event
+
+	case slog.KindString:
+		return event.Str(key, attr.Value.String())
+
+	case slog.KindInt64:
+		return event.Int64(key, attr.Value.Int64())
+
+	case slog.KindBool:
+		return event.Bool(key, attr.Value.Bool())
+
+	case slog.KindDuration:
+		return event.Dur(key, attr.Value.Duration())
+	}
+
+	if err, ok := attr.Value.Any().(error); ok {
+		return event.AnErr(key, err)
+	}
+
+	return event.Interface(key, attr.Value.Any())
+}
+
+// defaultLogger returns the logger used when a configuration does not provide one.
+func defaultLogger() *slog.Logger {
+	return slog.New(NewZerologHandler(disgo.Logger))
+}
+
+// operationAttrs returns the log attributes of an operation.
+func operationAttrs(operation *Operation) []any {
+	attrs := []any{slog.String(logKeyScope, string(operation.Scope))}
+
+	if operation.GuildID != "" {
+		attrs = append(attrs, slog.String(logKeyGuildID, operation.GuildID))
+	}
+
+	attrs = append(attrs,
+		slog.String(logKeyCommand, operation.Key().String()),
+		slog.String(logKeyOperation, string(operation.Action)),
+	)
+
+	if operation.CommandID != "" {
+		attrs = append(attrs, slog.String(logKeyCommandID, operation.CommandID))
+	}
+
+	return attrs
+}
+
+// logUnmanaged logs a planned operation on a current application command which is not owned.
+func logUnmanaged(c *Config, operation *Operation) {
+	c.Logger.Debug("application command is not managed", operationAttrs(operation)...)
+}
+
+// logOperation logs an executed operation.
+func logOperation(c *Config, operation *Operation, command *disgo.ApplicationCommand, duration time.Duration, err error) {
+	attrs := operationAttrs(operation)
+
+	if command != nil && command.ID != operation.CommandID {
+		attrs = append(attrs, slog.String(logKeyCommandID, command.ID))
+	}
+
+	attrs = append(attrs, slog.Duration(logKeyDuration, duration))
+
+	if len(operation.Diff) != 0 {
+		attrs = append(attrs, slog.String(logKeyDiff, operation.Diff.String()))
+	}
+
+	if err != nil {
+		c.Logger.Error("application command synchronization failed", append(attrs, slog.Any(logKeyError, err))...)
+
+		return
+	}
+
+	c.Logger.Info("application command synchronized", attrs...)
+}
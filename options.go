@@ -0,0 +1,222 @@
+package disgoform
+
+import (
+	"log/slog"
+	"slices"
+
+	"github.com/switchupcb/disgo"
+)
+
+// Option represents a synchronization option.
+//
+// Options modify a copy of the Syncer's Config for the duration of a call.
+type Option func(*Config)
+
+// DryRun returns an Option which performs every read request of a synchronization,
+// but does not send any create, edit, or delete request to Discord.
+//
+// Use the returned Result to review the requests that would have been sent.
+func DryRun() Option {
+	return func(c *Config) {
+		c.DryRun = true
+	}
+}
+
+// Strategy represents a strategy used to apply a plan.
+type Strategy int
+
+// Strategies.
+const (
+	// StrategyIncremental sends a create, edit, or delete request for each changed application command.
+	StrategyIncremental Strategy = iota
+
+	// StrategyBulk sends one bulk overwrite request for each scope with a changed application command.
+	//
+	// Application commands which exist on Discord keep their IDs.
+	StrategyBulk
+)
+
+// WithStrategy returns an Option which applies a plan using a strategy (default: StrategyIncremental).
+func WithStrategy(strategy Strategy) Option {
+	return func(c *Config) {
+		c.Strategy = strategy
+	}
+}
+
+// GuildDiscovery represents a method used to discover the guilds which are synchronized.
+type GuildDiscovery int
+
+// Guild Discovery Methods.
+const (
+	// GuildDiscoveryREST discovers every guild the bot is in using paginated Get Current User Guilds requests.
+	GuildDiscoveryREST GuildDiscovery = iota
+
+	// GuildDiscoveryDeclared discovers the guilds referenced by GuildApplicationCommands
+	// and the GuildIDs of GuildApplicationCommandTemplates.
+	//
+	// Guilds which are not referenced by a defined guild application command are not synchronized,
+	// and guilds the bot is not in are not detected prior to requesting their application commands.
+	GuildDiscoveryDeclared
+
+	// GuildDiscoveryGateway discovers every guild the bot is in using the Ready event of the Discord Gateway.
+	//
+	// WARNING: This method connects and disconnects from the Discord Gateway.
+	GuildDiscoveryGateway
+)
+
+// WithGuildDiscovery returns an Option which discovers the guilds that are synchronized
+// using a discovery method (default: GuildDiscoveryREST).
+func WithGuildDiscovery(discovery GuildDiscovery) Option {
+	return func(c *Config) {
+		c.GuildDiscovery = discovery
+	}
+}
+
+// WarnUnreachableGuilds returns an Option which logs a warning instead of returning an *UnreachableGuildError
+// when guild application commands are defined for guilds the bot is not in.
+func WarnUnreachableGuilds() Option {
+	return func(c *Config) {
+		c.WarnUnreachableGuilds = true
+	}
+}
+
+// GuildPolicy represents a policy used to determine the discovered guilds which are managed.
+//
+// The guild application commands of a guild which is not managed are never created, updated, or deleted.
+type GuildPolicy int
+
+// Guild Policies.
+const (
+	// GuildPolicyAll manages every discovered guild.
+	//
+	// Current guild application commands of a guild without defined guild application commands are deleted.
+	GuildPolicyAll GuildPolicy = iota
+
+	// GuildPolicyDeclared manages the discovered guilds referenced by GuildApplicationCommands
+	// and the discovered guilds which match a GuildApplicationCommandTemplate.
+	//
+	// The application commands of templates are deleted from the other discovered guilds.
+	GuildPolicyDeclared
+
+	// GuildPolicyAllowlist manages the discovered guilds of an allowlist.
+	GuildPolicyAllowlist
+)
+
+// WithGuildPolicy returns an Option which manages the discovered guilds determined by a policy (default: GuildPolicyAll).
+//
+// The guildIDs parameter represents the allowlist of the GuildPolicyAllowlist policy.
+func WithGuildPolicy(policy GuildPolicy, guildIDs ...string) Option {
+	return func(c *Config) {
+		c.GuildPolicy = policy
+		c.ManagedGuildIDs = guildIDs
+	}
+}
+
+// WithOwnedPrefix returns an Option which only edits and deletes the current application commands
+// with names that start with a prefix.
+//
+// Current application commands which are not owned are never changed, and are reported as unmanaged.
+func WithOwnedPrefix(prefix string) Option {
+	return func(c *Config) {
+		c.OwnedPrefix = prefix
+	}
+}
+
+// WithOwnedNames returns an Option which only edits and deletes the current application commands
+// with names that are in an allowlist.
+//
+// Current application commands which are not owned are never changed, and are reported as unmanaged.
+func WithOwnedNames(names ...string) Option {
+	return func(c *Config) {
+		c.OwnedNames = names
+	}
+}
+
+// WithStateFile returns an Option which records the IDs of synchronized application commands in a state file,
+// then only edits and deletes the current application commands which are owned by name or recorded.
+//
+// Current application commands which are not owned are never changed, and are reported as unmanaged.
+// A defined application command which exists, but is not owned, is a conflict unless the AdoptCommands option is used.
+func WithStateFile(name string) Option {
+	return func(c *Config) {
+		c.StateFile = name
+	}
+}
+
+// AdoptCommands returns an Option which edits the current application commands which are defined,
+// but not recorded in the state file, then records them (WithStateFile).
+//
+// Use AdoptCommands once to adopt the application commands of a configuration which starts using a state file.
+func AdoptCommands() Option {
+	return func(c *Config) {
+		c.Adopt = true
+	}
+}
+
+// WithPreventDestroy returns an Option which adds protected application commands, so a *DeletionError is returned
+// instead of synchronizing when a protected application command would be deleted (or recreated).
+func WithPreventDestroy(commands ...ProtectedCommand) Option {
+	return func(c *Config) {
+		c.PreventDestroy = append(slices.Clip(c.PreventDestroy), commands...)
+	}
+}
+
+// WithMaxDeletions returns an Option which returns a *DeletionError instead of synchronizing
+// when a plan deletes more application commands than a maximum (a negative maximum represents no maximum).
+//
+// Recreated application commands count as deletions, since a recreate deletes the current application command
+// (and its ID and permission overwrites). The maximum applies to the Global and Guild application commands of a Sync combined,
+// which are planned before any operation is executed. Use Destroy to delete every application command.
+func WithMaxDeletions(deletions int) Option {
+	return func(c *Config) {
+		c.MaxDeletions = &deletions
+	}
+}
+
+// ContinueOnError returns an Option which attempts every operation of a synchronization (and every guild)
+// when an operation fails, then returns an *AggregateError containing every failure.
+//
+// The returned Result contains the operations which succeeded.
+func ContinueOnError() Option {
+	return func(c *Config) {
+		c.ContinueOnError = true
+	}
+}
+
+// WithLogger returns an Option which logs synchronization events using a logger.
+//
+// Use slog.New(slog.NewTextHandler(io.Discard, nil)) to silence synchronization events.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Config) {
+		c.Logger = logger
+	}
+}
+
+// RequireLocales returns an Option which validates that every application command, option, and choice
+// provides a localization for each locale (Validate).
+func RequireLocales(locales ...string) Option {
+	return func(c *Config) {
+		c.RequiredLocales = locales
+	}
+}
+
+// WithEntryPointHandler returns an Option which sets the entry point handler of the PRIMARY_ENTRY_POINT
+// global application command (e.g., disgo.FlagEntryPointCommandHandlerTypesDISCORD_LAUNCH_ACTIVITY).
+//
+// disgo.CreateGlobalApplicationCommand does not contain a handler, so a created application command
+// uses this handler, and a current application command with a different handler is updated.
+func WithEntryPointHandler(handler disgo.Flag) Option {
+	return func(c *Config) {
+		c.EntryPointHandler = &handler
+	}
+}
+
+// WithPermissionsAuthentication returns an Option which sets the Bearer token used to edit application command permissions.
+//
+// The Bearer token must be authorized with the applications.commands.permissions.update scope
+// by a user who can manage the guild and its roles.
+func WithPermissionsAuthentication(authentication *disgo.Authentication) Option {
+	return func(c *Config) {
+		c.PermissionsAuthentication = authentication
+	}
+}
@@ -0,0 +1,207 @@
+package disgoform
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+)
+
+// stateFile represents the application commands which are created (or adopted) by a configuration.
+type stateFile struct {
+	Commands []*stateCommand `json:"commands"`
+}
+
+// stateCommand represents an application command of a state file.
+type stateCommand struct {
+	// ID represents the Discord-assigned ID of the application command.
+	ID string `json:"id"`
+
+	// GuildID represents the guild of a guild application command.
+	GuildID string `json:"guild_id,omitempty"`
+
+	// Name represents the name of the application command.
+	Name string `json:"name"`
+
+	// Type represents the type of the application command (e.g., CHAT_INPUT).
+	Type string `json:"type"`
+}
+
+// readStateFile reads a state file, or returns an empty state file when it does not exist.
+func readStateFile(name string) (*stateFile, error) {
+	state := &stateFile{Commands: nil}
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return state, nil
+		}
+
+		return nil, fmt.Errorf("cannot read state file: %w", err)
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("cannot read state file %q: %w", name, err)
+	}
+
+	return state, nil
+}
+
+// writeStateFile writes a state file.
+func writeStateFile(name string, state *stateFile) error {
+	slices.SortFunc(state.Commands, func(x, y *stateCommand) int {
+		return cmp.Or(
+			cmp.Compare(x.GuildID, y.GuildID),
+			cmp.Compare(x.Name, y.Name),
+			cmp.Compare(x.Type, y.Type),
+			cmp.Compare(x.ID, y.ID),
+		)
+	})
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cannot write state file %q: %w", name, err)
+	}
+
+	if err := os.WriteFile(name, append(data, '\n'), 0o644); err != nil { //nolint:gosec
+		return fmt.Errorf("cannot write state file: %w", err)
+	}
+
+	return nil
+}
+
+// ownership represents the current application commands which are owned by a configuration.
+type ownership struct {
+	// prefix represents the name prefix of owned application commands.
+	prefix string
+
+	// names represents the names of owned application commands.
+	names []string
+
+	// ids represents the IDs of the application commands of the state file (nil without a state file).
+	ids map[string]bool
+
+	// adopt represents whether current application commands which are defined are owned.
+	adopt bool
+}
+
+// newOwnership returns the ownership of a configuration.
+func newOwnership(c *Config) (*ownership, error) {
+	o := &ownership{
+		prefix: c.OwnedPrefix,
+		names:  c.OwnedNames,
+		ids:    nil,
+		adopt:  c.Adopt,
+	}
+
+	if c.StateFile == "" {
+		return o, nil
+	}
+
+	state, err := readStateFile(c.StateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	o.ids = make(map[string]bool, len(state.Commands))
+	for _, command := range state.Commands {
+		o.ids[command.ID] = true
+	}
+
+	return o, nil
+}
+
+// unrestricted returns whether every current application command is owned.
+func (o *ownership) unrestricted() bool {
+	return o.prefix == "" && len(o.names) == 0 && o.ids == nil
+}
+
+// ownsName returns whether an application command is owned by its name.
+func (o *ownership) ownsName(name string) bool {
+	return (o.prefix != "" && strings.HasPrefix(name, o.prefix)) || slices.Contains(o.names, name)
+}
+
+// owns returns whether a current application command which is not defined is owned.
+func (o *ownership) owns(key CommandKey, commandID string) bool {
+	return o.unrestricted() || o.ownsName(key.Name) || o.ids[commandID]
+}
+
+// define returns an error when a defined application command is not owned.
+//
+// A defined application command is owned by the state file, which records it once it's created,
+// but a current application command with its key must be owned to be edited (edit).
+func (o *ownership) define(key CommandKey) error {
+	if o.ids != nil || o.unrestricted() || o.ownsName(key.Name) {
+		return nil
+	}
+
+	return fmt.Errorf("cannot define %s command %q which is not owned (WithOwnedPrefix, WithOwnedNames)", commandTypeName(key.Type), key.Name)
+}
+
+// edit returns an error when a current application command which is defined is not owned,
+// so an application command created by another service is never edited and recorded (AdoptCommands).
+func (o *ownership) edit(key CommandKey, commandID string) error {
+	if o.adopt || o.owns(key, commandID) {
+		return nil
+	}
+
+	return fmt.Errorf("cannot edit %s command %q (%s) which is not recorded in the state file (AdoptCommands)", commandTypeName(key.Type), key.Name, commandID)
+}
+
+// recordState records the application commands of executed operations in the state file of a configuration.
+func recordState(c *Config, executions []execution) error {
+	if c.StateFile == "" || len(executions) == 0 {
+		return nil
+	}
+
+	state, err := readStateFile(c.StateFile)
+	if err != nil {
+		return err
+	}
+
+	commands := make(map[string]*stateCommand, len(state.Commands))
+	for _, command := range state.Commands {
+		commands[command.ID] = command
+	}
+
+	for _, executed := range executions {
+		operation := executed.operation
+
+		switch operation.Action {
+		case ActionDelete:
+			delete(commands, operation.CommandID)
+
+		case ActionCreate, ActionUpdate, ActionRecreate, ActionNoOp:
+			if operation.Action == ActionRecreate {
+				delete(commands, operation.CommandID)
+			}
+
+			id := operation.CommandID
+			if executed.command != nil {
+				id = executed.command.ID
+			}
+
+			if id == "" {
+				continue
+			}
+
+			commands[id] = &stateCommand{
+				ID:      id,
+				GuildID: operation.GuildID,
+				Name:    operation.Name,
+				Type:    commandTypeName(operation.Type),
+			}
+
+		case ActionUnmanaged:
+		}
+	}
+
+	state.Commands = slices.Collect(maps.Values(commands))
+
+	return writeStateFile(c.StateFile, state)
+}
@@ -0,0 +1,287 @@
+package disgoform
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/switchupcb/disgo"
+)
+
+// PatchedApplicationCommand represents a base guild application command which is created in multiple guilds
+// with per-guild modifications.
+//
+// Patched application commands are expanded into guild application commands before a synchronization.
+type PatchedApplicationCommand struct {
+	// Command represents the base application command (the GuildID is ignored).
+	Command disgo.CreateGuildApplicationCommand
+
+	// Patches represents the guilds which the application command is created in,
+	// and the modifications of the base application command in each guild.
+	Patches []GuildPatch
+}
+
+// GuildPatch represents the modifications of a base application command in a guild.
+//
+// A GuildPatch without modifications creates the base application command in the guild.
+type GuildPatch struct {
+	// GuildID represents the guild which the patched application command is created in.
+	GuildID string
+
+	// Description replaces the description of the base application command.
+	Description *string
+
+	// DescriptionLocalizations replaces the description localizations of the base application command.
+	DescriptionLocalizations *map[string]string
+
+	// DefaultMemberPermissions replaces the default member permissions of the base application command.
+	//
+	// Use disgo.Pointer2 to set a value, or a pointer to a nil *string to allow every member.
+	DefaultMemberPermissions **string
+
+	// NSFW replaces the age-restriction of the base application command.
+	NSFW *bool
+
+	// Options represents the modifications of the options of the base application command.
+	Options []OptionsPatch
+
+	// Choices represents the modifications of the choices of the base application command's options.
+	Choices []ChoicesPatch
+}
+
+// OptionsPatch represents the modifications of the options of an application command or subcommand.
+type OptionsPatch struct {
+	// Path represents the space-separated names of the subcommand group or subcommand
+	// which contains the options (e.g., "settings" or "settings edit").
+	//
+	// An empty Path represents the options of the application command.
+	Path string
+
+	// Remove represents the names of the options which are removed.
+	Remove []string
+
+	// Add represents the options which are added (or replace an option with the same name).
+	Add []*disgo.ApplicationCommandOption
+}
+
+// ChoicesPatch represents the modifications of the choices of an option.
+type ChoicesPatch struct {
+	// Path represents the space-separated names of the option which contains the choices,
+	// which are prefixed by the names of its subcommand group and subcommand (e.g., "color" or "settings edit color").
+	Path string
+
+	// Remove represents the names of the choices which are removed.
+	Remove []string
+
+	// Add represents the choices which are added (or replace a choice with the same name).
+	Add []*disgo.ApplicationCommandOptionChoice
+}
+
+// Expand returns the guild application commands of a patched application command in each guild.
+func (p *PatchedApplicationCommand) Expand() ([]disgo.CreateGuildApplicationCommand, error) {
+	commands := make([]disgo.CreateGuildApplicationCommand, len(p.Patches))
+
+	for i := range p.Patches {
+		command, err := p.Patches[i].apply(p.Command)
+		if err != nil {
+			return nil, fmt.Errorf("Expand: %s (guild %q): %w", p.Command.Name, p.Patches[i].GuildID, err)
+		}
+
+		commands[i] = command
+	}
+
+	return commands, nil
+}
+
+// expandPatchedApplicationCommands appends the expanded guild application commands of the patched application commands
+// to the guild application commands of a configuration.
+func expandPatchedApplicationCommands(c *Config) error {
+	if len(c.PatchedApplicationCommands) == 0 {
+		return nil
+	}
+
+	commands := slices.Clip(c.GuildApplicationCommands)
+
+	for i := range c.PatchedApplicationCommands {
+		expanded, err := c.PatchedApplicationCommands[i].Expand()
+		if err != nil {
+			return err
+		}
+
+		commands = append(commands, expanded...)
+	}
+
+	c.GuildApplicationCommands = commands
+
+	return nil
+}
+
+// apply returns a guild application command which is the base application command modified by the patch.
+func (p *GuildPatch) apply(base disgo.CreateGuildApplicationCommand) (disgo.CreateGuildApplicationCommand, error) {
+	command := base
+	command.GuildID = p.GuildID
+	command.Options = cloneOptions(base.Options)
+
+	if p.Description != nil {
+		command.Description = p.Description
+	}
+
+	if p.DescriptionLocalizations != nil {
+		command.DescriptionLocalizations = p.DescriptionLocalizations
+	}
+
+	if p.DefaultMemberPermissions != nil {
+		command.DefaultMemberPermissions = p.DefaultMemberPermissions
+	}
+
+	if p.NSFW != nil {
+		command.NSFW = p.NSFW
+	}
+
+	for _, patch := range p.Options {
+		options := &command.Options
+
+		if patch.Path != "" {
+			option, err := findOption(command.Options, patch.Path)
+			if err != nil {
+				return command, err
+			}
+
+			options = &option.Options
+		}
+
+		for _, name := range patch.Remove {
+			index := slices.IndexFunc(*options, func(option *disgo.ApplicationCommandOption) bool {
+				return option != nil && option.Name == name
+			})
+
+			if index == -1 {
+				return command, fmt.Errorf("cannot remove option %q: option not found", strings.TrimSpace(patch.Path+" "+name))
+			}
+
+			*options = slices.Delete(*options, index, index+1)
+		}
+
+		for _, add := range patch.Add {
+			if add == nil {
+				return command, fmt.Errorf("cannot add nil option to options %q", patch.Path)
+			}
+
+			option := cloneOption(add)
+
+			index := slices.IndexFunc(*options, func(option *disgo.ApplicationCommandOption) bool {
+				return option != nil && option.Name == add.Name
+			})
+
+			if index == -1 {
+				*options = append(*options, option)
+			} else {
+				(*options)[index] = option
+			}
+		}
+	}
+
+	for _, patch := range p.Choices {
+		option, err := findOption(command.Options, patch.Path)
+		if err != nil {
+			return command, err
+		}
+
+		for _, name := range patch.Remove {
+			index := slices.IndexFunc(option.Choices, func(choice *disgo.ApplicationCommandOptionChoice) bool {
+				return choice != nil && choice.Name == name
+			})
+
+			if index == -1 {
+				return command, fmt.Errorf("cannot remove choice %q of option %q: choice not found", name, patch.Path)
+			}
+
+			option.Choices = slices.Delete(option.Choices, index, index+1)
+		}
+
+		for _, add := range patch.Add {
+			if add == nil {
+				return command, fmt.Errorf("cannot add nil choice to option %q", patch.Path)
+			}
+
+			choice := *add
+
+			index := slices.IndexFunc(option.Choices, func(choice *disgo.ApplicationCommandOptionChoice) bool {
+				return choice != nil && choice.Name == add.Name
+			})
+
+			if index == -1 {
+				option.Choices = append(option.Choices, &choice)
+			} else {
+				option.Choices[index] = &choice
+			}
+		}
+	}
+
+	return command, nil
+}
+
+// findOption returns the option of a space-separated path of option names.
+func findOption(options []*disgo.ApplicationCommandOption, path string) (*disgo.ApplicationCommandOption, error) {
+	names := strings.Fields(path)
+	if len(names) == 0 {
+		return nil, fmt.Errorf("option path %q is empty", path)
+	}
+
+	var option *disgo.ApplicationCommandOption
+
+	for _, name := range names {
+		index := slices.IndexFunc(options, func(option *disgo.ApplicationCommandOption) bool {
+			return option != nil && option.Name == name
+		})
+
+		if index == -1 {
+			return nil, fmt.Errorf("option %q not found", path)
+		}
+
+		option = options[index]
+		options = option.Options
+	}
+
+	return option, nil
+}
+
+// cloneOptions returns a deep copy of options, so a patch does not modify the options of the base application command.
+func cloneOptions(options []*disgo.ApplicationCommandOption) []*disgo.ApplicationCommandOption {
+	if options == nil {
+		return nil
+	}
+
+	clones := make([]*disgo.ApplicationCommandOption, len(options))
+	for i, option := range options {
+		clones[i] = cloneOption(option)
+	}
+
+	return clones
+}
+
+// cloneOption returns a deep copy of an option.
+//
+// A nil option or choice is copied as nil, so Validate reports it.
+func cloneOption(option *disgo.ApplicationCommandOption) *disgo.ApplicationCommandOption {
+	if option == nil {
+		return nil
+	}
+
+	clone := *option
+	clone.Options = cloneOptions(option.Options)
+
+	if option.Choices != nil {
+		clone.Choices = make([]*disgo.ApplicationCommandOptionChoice, len(option.Choices))
+		for i, choice := range option.Choices {
+			if choice == nil {
+				continue
+			}
+
+			c := *choice
+			clone.Choices[i] = &c
+		}
+	}
+
+	return &clone
+}
@@ -0,0 +1,411 @@
+package disgoform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/switchupcb/disgo"
+)
+
+// ErrPermissionsAuthentication represents an error that occurs when application command permissions
+// are synchronized without a Bearer token.
+var ErrPermissionsAuthentication = errors.New("cannot edit application command permissions without a Bearer token " +
+	"with the applications.commands.permissions.update scope (use the WithPermissionsAuthentication option)")
+
+// CommandPermissions represents the permission overwrites of an application command in a guild.
+//
+// https://discord.com/developers/docs/interactions/application-commands#permissions
+type CommandPermissions struct {
+	// GuildID represents the guild of the permission overwrites.
+	GuildID string
+
+	// Name represents the name of the application command (global or guild).
+	//
+	// An empty Name represents the application-wide permission overwrites,
+	// which apply to every application command without permission overwrites.
+	Name string
+
+	// Type represents the type of the application command (default: CHAT_INPUT).
+	Type *disgo.Flag
+
+	// Permissions represents the permission overwrites of the application command (max: 100).
+	//
+	// Use EveryonePermissionID and AllChannelsPermissionID to overwrite the permissions of
+	// every member and every channel of the guild.
+	//
+	// Empty Permissions removes every permission overwrite of the application command.
+	Permissions []*disgo.ApplicationCommandPermissions
+}
+
+// Key returns the key of the application command of the permission overwrites.
+func (p *CommandPermissions) Key() CommandKey {
+	return commandKey(p.Type, p.Name)
+}
+
+// EveryonePermissionID returns the ID of a ROLE permission overwrite which represents every member of a guild (@everyone).
+func EveryonePermissionID(guildID string) string {
+	return guildID
+}
+
+// AllChannelsPermissionID returns the ID of a CHANNEL permission overwrite which represents every channel of a guild.
+func AllChannelsPermissionID(guildID string) (string, error) {
+	id, err := strconv.ParseUint(guildID, 10, 64)
+	if err != nil || id == 0 {
+		return "", fmt.Errorf("AllChannelsPermissionID: invalid guild ID %q", guildID)
+	}
+
+	return strconv.FormatUint(id-1, 10), nil
+}
+
+// PermissionsResult represents the synchronized permission overwrites of an application command in a guild.
+type PermissionsResult struct {
+	// GuildID represents the guild of the permission overwrites.
+	GuildID string `json:"guild_id"`
+
+	// Name represents the name of the application command (empty for the application-wide permission overwrites).
+	Name string `json:"name,omitempty"`
+
+	// Type represents the type of the application command (e.g., CHAT_INPUT).
+	Type string `json:"type,omitempty"`
+
+	// CommandID represents the ID of the application command (or application).
+	//
+	// CommandID is empty for an application command which is created in a dry run.
+	CommandID string `json:"command_id,omitempty"`
+
+	// Action represents the action performed on the permission overwrites (update, no-op).
+	Action Action `json:"action"`
+}
+
+// String returns a human-readable representation of the permissions result.
+func (r *PermissionsResult) String() string {
+	if r.Name == "" {
+		return fmt.Sprintf("guild %q: %s application permissions", r.GuildID, r.Action)
+	}
+
+	return fmt.Sprintf("guild %q: %s permissions %s %q", r.GuildID, r.Action, r.Type, r.Name)
+}
+
+// SyncApplicationCommandPermissions synchronizes ApplicationCommandPermissions.
+func SyncApplicationCommandPermissions(bot *disgo.Client, opts ...Option) (*Result, error) {
+	return defaultSyncer(bot).SyncApplicationCommandPermissionsContext(context.Background(), opts...)
+}
+
+// SyncApplicationCommandPermissionsContext synchronizes ApplicationCommandPermissions using a context.
+func SyncApplicationCommandPermissionsContext(ctx context.Context, bot *disgo.Client, opts ...Option) (*Result, error) {
+	return defaultSyncer(bot).SyncApplicationCommandPermissionsContext(ctx, opts...)
+}
+
+// SyncApplicationCommandPermissions synchronizes the application command permissions of the Syncer's configuration.
+func (s *Syncer) SyncApplicationCommandPermissions(opts ...Option) (*Result, error) {
+	return s.SyncApplicationCommandPermissionsContext(context.Background(), opts...)
+}
+
+// SyncApplicationCommandPermissionsContext synchronizes the application command permissions
+// of the Syncer's configuration using a context.
+//
+// The permission overwrites of an application command are only edited when they differ from the declared permission overwrites.
+// Application commands without declared permission overwrites are not changed.
+//
+// Editing permission overwrites requires a Bearer token (WithPermissionsAuthentication),
+// while application commands and permission overwrites are read using the Client's authentication.
+//
+// SyncApplicationCommandPermissionsContext stops sending requests to Discord when the context is canceled
+// after the request in progress (including its rate limit wait).
+func (s *Syncer) SyncApplicationCommandPermissionsContext(ctx context.Context, opts ...Option) (*Result, error) {
+	c, err := s.config(opts)
+	if err != nil {
+		return nil, fmt.Errorf("SyncApplicationCommandPermissions: %w", err)
+	}
+
+	result := newResult(nil, nil, c.DryRun)
+
+	result.Permissions, err = syncPermissions(ctx, c)
+	if err != nil {
+		return result, fmt.Errorf("SyncApplicationCommandPermissions: %w", err)
+	}
+
+	return result, nil
+}
+
+// syncPermissions synchronizes the application command permissions of a configuration.
+func syncPermissions(ctx context.Context, c *Config) ([]*PermissionsResult, error) {
+	if len(c.ApplicationCommandPermissions) == 0 {
+		return nil, nil
+	}
+
+	if !c.DryRun && c.PermissionsAuthentication == nil {
+		return nil, ErrPermissionsAuthentication
+	}
+
+	// map the declared permission overwrites of each guild.
+	var guildIDs []string
+
+	definedPermissionsGuildIDMap := make(map[string][]*CommandPermissions)
+
+	for i := range c.ApplicationCommandPermissions {
+		permissions := &c.ApplicationCommandPermissions[i]
+
+		if permissions.GuildID == "" {
+			return nil, fmt.Errorf("permissions of application command %v do not specify a guild", permissions.Key())
+		}
+
+		definedPermissions, ok := definedPermissionsGuildIDMap[permissions.GuildID]
+		if !ok {
+			guildIDs = append(guildIDs, permissions.GuildID)
+		}
+
+		for _, defined := range definedPermissions {
+			if defined.Key() == permissions.Key() {
+				return nil, fmt.Errorf("guild %q: more than one permissions are declared for application command %v", permissions.GuildID, permissions.Key())
+			}
+		}
+
+		definedPermissionsGuildIDMap[permissions.GuildID] = append(definedPermissions, permissions)
+	}
+
+	currentGlobalCommands, err := getApplicationCommands(ctx, c.Client, ScopeGlobal, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		results []*PermissionsResult
+		errs    []*OperationError
+	)
+
+	for _, guildID := range guildIDs {
+		guildResults, guildErrs, err := syncGuildPermissions(ctx, c, guildID, definedPermissionsGuildIDMap[guildID], currentGlobalCommands)
+		results = append(results, guildResults...)
+		errs = append(errs, guildErrs...)
+
+		if err != nil {
+			if !c.ContinueOnError || ctx.Err() != nil {
+				return results, err
+			}
+
+			errs = append(errs, &OperationError{
+				Scope:   ScopeGuild,
+				GuildID: guildID,
+				Command: CommandKey{Name: "", Type: 0},
+				Action:  "",
+				Err:     err,
+			})
+		}
+	}
+
+	return results, newAggregateError(errs)
+}
+
+// syncGuildPermissions synchronizes the application command permissions of a guild.
+//
+// syncGuildPermissions returns the operation errors which occur using the ContinueOnError option.
+func syncGuildPermissions(
+	ctx context.Context,
+	c *Config,
+	guildID string,
+	definedPermissions []*CommandPermissions,
+	currentGlobalCommands []*disgo.ApplicationCommand,
+) ([]*PermissionsResult, []*OperationError, error) {
+	currentGuildCommands, err := getApplicationCommands(ctx, c.Client, ScopeGuild, guildID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// guild application commands take precedence over global application commands with the same key.
+	commandIDs := make(map[CommandKey]string, len(currentGlobalCommands)+len(currentGuildCommands))
+	for _, command := range slices.Concat(currentGlobalCommands, currentGuildCommands) {
+		commandIDs[commandKey(command.Type, command.Name)] = command.ID
+	}
+
+	getPermissions := &getGuildApplicationCommandPermissions{GuildID: guildID}
+
+	currentPermissions, err := getPermissions.Send(c.Client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot get current guild %q application command permissions: %w", guildID, err)
+	}
+
+	currentPermissionsCommandIDMap := make(map[string][]*disgo.ApplicationCommandPermissions, len(currentPermissions))
+	for _, permissions := range currentPermissions {
+		currentPermissionsCommandIDMap[permissions.ID] = permissions.Permissions
+	}
+
+	var (
+		results []*PermissionsResult
+		errs    []*OperationError
+	)
+
+	for _, permissions := range definedPermissions {
+		if err := ctx.Err(); err != nil {
+			return results, errs, err
+		}
+
+		key := permissions.Key()
+		result := &PermissionsResult{
+			GuildID:   guildID,
+			Name:      permissions.Name,
+			Type:      "",
+			CommandID: c.Client.ApplicationID,
+			Action:    ActionNoOp,
+		}
+
+		if permissions.Name != "" {
+			result.Type = commandTypeName(key.Type)
+			result.CommandID = commandIDs[key]
+		}
+
+		if result.CommandID == "" && !c.DryRun {
+			err := fmt.Errorf("guild %q: cannot edit permissions of application command %v which does not exist", guildID, key)
+			if !c.ContinueOnError {
+				return results, errs, err
+			}
+
+			errs = append(errs, newPermissionsError(guildID, key, err))
+
+			continue
+		}
+
+		// an application command which is created in a dry run has no current permissions.
+		if result.CommandID != "" && equalPermissions(currentPermissionsCommandIDMap[result.CommandID], permissions.Permissions) {
+			results = append(results, result)
+
+			continue
+		}
+
+		result.Action = ActionUpdate
+
+		if !c.DryRun {
+			start := time.Now()
+			err := editPermissions(c, guildID, result.CommandID, permissions.Permissions)
+			logPermissions(c, result, time.Since(start), err)
+
+			if err != nil {
+				err = fmt.Errorf("cannot edit guild %q permissions of application command %v: %w", guildID, key, err)
+				if !c.ContinueOnError {
+					return results, errs, err
+				}
+
+				errs = append(errs, newPermissionsError(guildID, key, err))
+
+				continue
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results, errs, nil
+}
+
+// newPermissionsError returns an error that occurs while editing the permissions of an application command.
+func newPermissionsError(guildID string, key CommandKey, err error) *OperationError {
+	return &OperationError{
+		Scope:   ScopeGuild,
+		GuildID: guildID,
+		Command: key,
+		Action:  ActionUpdate,
+		Err:     err,
+	}
+}
+
+// editPermissions edits the permission overwrites of an application command using the permissions authentication.
+func editPermissions(c *Config, guildID, commandID string, permissions []*disgo.ApplicationCommandPermissions) error {
+	bot := *c.Client
+	bot.Authentication = bearerAuthentication(c.PermissionsAuthentication)
+
+	if permissions == nil {
+		permissions = []*disgo.ApplicationCommandPermissions{}
+	}
+
+	editPermissions := &disgo.EditApplicationCommandPermissions{
+		GuildID:     guildID,
+		CommandID:   commandID,
+		Permissions: permissions,
+	}
+
+	_, err := editPermissions.Send(&bot)
+
+	return err //nolint:wrapcheck
+}
+
+// bearerAuthentication returns an authentication with a valid Authorization header.
+//
+// disgo.BearerToken returns an Authorization header without a space between the token type and token.
+func bearerAuthentication(authentication *disgo.Authentication) *disgo.Authentication {
+	if authentication.TokenType != "Bearer" || strings.HasPrefix(authentication.Header, "Bearer ") {
+		return authentication
+	}
+
+	return &disgo.Authentication{
+		Token:     authentication.Token,
+		TokenType: authentication.TokenType,
+		Header:    "Bearer " + authentication.Token,
+	}
+}
+
+// equalPermissions returns whether two sets of permission overwrites are equal (regardless of order).
+//
+// Permission overwrites are compared as multisets, so a set with a duplicate (or nil) permission overwrite
+// is never equal to a set without it.
+func equalPermissions(x, y []*disgo.ApplicationCommandPermissions) bool {
+	if len(x) != len(y) {
+		return false
+	}
+
+	type overwrite struct {
+		id         string
+		typ        disgo.Flag
+		permission bool
+		null       bool
+	}
+
+	key := func(permission *disgo.ApplicationCommandPermissions) overwrite {
+		if permission == nil {
+			return overwrite{id: "", typ: 0, permission: false, null: true}
+		}
+
+		return overwrite{id: permission.ID, typ: permission.Type, permission: permission.Permission, null: false}
+	}
+
+	overwrites := make(map[overwrite]int, len(x))
+	for _, permission := range x {
+		overwrites[key(permission)]++
+	}
+
+	for _, permission := range y {
+		k := key(permission)
+		if overwrites[k] == 0 {
+			return false
+		}
+
+		overwrites[k]--
+	}
+
+	return true
+}
+
+// logPermissions logs the result of editing the permission overwrites of an application command.
+func logPermissions(c *Config, result *PermissionsResult, duration time.Duration, err error) {
+	attrs := []any{
+		slog.String(logKeyScope, string(ScopeGuild)),
+		slog.String(logKeyGuildID, result.GuildID),
+		slog.String(logKeyCommand, result.Name),
+		slog.String(logKeyCommandID, result.CommandID),
+		slog.String(logKeyOperation, "permissions"),
+		slog.Duration(logKeyDuration, duration),
+	}
+
+	if err != nil {
+		c.Logger.Error("application command permissions failed", append(attrs, slog.Any(logKeyError, err))...)
+
+		return
+	}
+
+	c.Logger.Info("application command permissions synchronized", attrs...)
+}
@@ -0,0 +1,879 @@
+package disgoform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/switchupcb/disgo"
+)
+
+// Action represents an action performed on an application command.
+type Action string
+
+// Actions.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+	ActionNoOp   Action = "no-op"
+
+	// ActionRecreate deletes then creates an application command because
+	// Discord cannot edit a changed field (e.g., Type) in place.
+	ActionRecreate Action = "recreate"
+
+	// ActionUnmanaged represents a current application command which is not owned,
+	// so it's never changed (WithOwnedPrefix, WithOwnedNames, WithStateFile).
+	ActionUnmanaged Action = "unmanaged"
+)
+
+// changes returns whether the action modifies an application command.
+func (a Action) changes() bool {
+	return a != ActionNoOp && a != ActionUnmanaged
+}
+
+// Scope represents the scope of an application command.
+type Scope string
+
+// Scopes.
+const (
+	ScopeGlobal Scope = "global"
+	ScopeGuild  Scope = "guild"
+)
+
+// Operation represents a planned action on an application command.
+type Operation struct {
+	// Global represents the defined global application command of a create or update operation
+	// (or the current global application command of an unmanaged operation).
+	Global *disgo.CreateGlobalApplicationCommand
+
+	// Guild represents the defined guild application command of a create or update operation
+	// (or the current guild application command of an unmanaged operation).
+	Guild *disgo.CreateGuildApplicationCommand
+
+	// Action represents the action performed on the application command.
+	Action Action
+
+	// Scope represents the scope of the application command.
+	Scope Scope
+
+	// GuildID represents the guild of a guild application command.
+	GuildID string
+
+	// Name represents the name of the application command.
+	Name string
+
+	// Type represents the type of the application command.
+	Type disgo.Flag
+
+	// CommandID represents the ID of the current application command (update, delete, recreate, no-op, unmanaged).
+	CommandID string
+
+	// Handler represents the entry point handler of a PRIMARY_ENTRY_POINT application command
+	// (the EntryPointHandler option, or the handler of the current application command when unset).
+	Handler *disgo.Flag
+
+	// Diff represents the differences between the current and defined application command (update, recreate).
+	Diff Diff
+}
+
+// String returns a human-readable representation of the operation.
+func (o *Operation) String() string {
+	var b strings.Builder
+
+	if o.Scope == ScopeGuild {
+		fmt.Fprintf(&b, "guild %q: ", o.GuildID)
+	} else {
+		fmt.Fprintf(&b, "%s: ", o.Scope)
+	}
+
+	fmt.Fprintf(&b, "%s %v", o.Action, o.Key())
+
+	if fields := o.Diff.Fields(); len(fields) != 0 {
+		fmt.Fprintf(&b, " (%s)", strings.Join(fields, ", "))
+	}
+
+	return b.String()
+}
+
+// Key returns the key of the operation's application command.
+func (o *Operation) Key() CommandKey {
+	return CommandKey{
+		Name: o.Name,
+		Type: o.Type,
+	}
+}
+
+// State represents the application command state of a scope at the time a plan is computed.
+type State struct {
+	// Versions represents a map of application command IDs to application command versions.
+	Versions map[string]string
+
+	// Scope represents the scope of the application commands.
+	Scope Scope
+
+	// GuildID represents the guild of guild application commands.
+	GuildID string
+}
+
+// ChangePlan represents the operations required to synchronize application commands.
+type ChangePlan struct {
+	// Operations represents the operations of the plan in order of execution.
+	Operations []*Operation
+
+	// States represents the application command state the plan is computed from.
+	States []*State
+}
+
+// HasChanges returns whether the plan contains an operation which modifies an application command.
+func (p *ChangePlan) HasChanges() bool {
+	for _, operation := range p.Operations {
+		if operation.Action.changes() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns a human-readable representation of the plan.
+func (p *ChangePlan) String() string {
+	return p.Format(false)
+}
+
+// Format returns a human-readable representation of the plan
+// which includes the differences of each update operation.
+//
+// Differences are colored using ANSI escape codes when color is true.
+func (p *ChangePlan) Format(color bool) string {
+	var b strings.Builder
+
+	for _, operation := range p.Operations {
+		b.WriteString(operation.String())
+		b.WriteByte('\n')
+
+		for _, line := range strings.SplitAfter(operation.Diff.Format(color), "\n") {
+			if line != "" {
+				b.WriteString("    ")
+				b.WriteString(line)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// merge merges a plan into the plan.
+func (p *ChangePlan) merge(plan *ChangePlan) {
+	p.Operations = append(p.Operations, plan.Operations...)
+	p.States = append(p.States, plan.States...)
+}
+
+// Plan computes the operations required to synchronize Global and Guild application commands.
+func Plan(bot *disgo.Client, opts ...Option) (*ChangePlan, error) {
+	return defaultSyncer(bot).PlanContext(context.Background(), opts...)
+}
+
+// PlanContext computes the operations required to synchronize Global and Guild application commands using a context.
+func PlanContext(ctx context.Context, bot *disgo.Client, opts ...Option) (*ChangePlan, error) {
+	return defaultSyncer(bot).PlanContext(ctx, opts...)
+}
+
+// PlanGlobalApplicationCommands computes the operations required to synchronize Global application commands.
+func PlanGlobalApplicationCommands(bot *disgo.Client, opts ...Option) (*ChangePlan, error) {
+	return defaultSyncer(bot).PlanGlobalApplicationCommandsContext(context.Background(), opts...)
+}
+
+// PlanGlobalApplicationCommandsContext computes the operations required to synchronize Global application commands using a context.
+func PlanGlobalApplicationCommandsContext(ctx context.Context, bot *disgo.Client, opts ...Option) (*ChangePlan, error) {
+	return defaultSyncer(bot).PlanGlobalApplicationCommandsContext(ctx, opts...)
+}
+
+// PlanGuildApplicationCommands computes the operations required to synchronize Guild application commands.
+//
+// The guilds which are synchronized are discovered using the WithGuildDiscovery option,
+// then filtered using the WithGuildPolicy option. An *UnreachableGuildError is returned when guild application commands are defined
+// for guilds the bot is not in, unless the WarnUnreachableGuilds option is used.
+func PlanGuildApplicationCommands(bot *disgo.Client, opts ...Option) (*ChangePlan, error) {
+	return defaultSyncer(bot).PlanGuildApplicationCommandsContext(context.Background(), opts...)
+}
+
+// PlanGuildApplicationCommandsContext computes the operations required to synchronize Guild application commands using a context.
+func PlanGuildApplicationCommandsContext(ctx context.Context, bot *disgo.Client, opts ...Option) (*ChangePlan, error) {
+	return defaultSyncer(bot).PlanGuildApplicationCommandsContext(ctx, opts...)
+}
+
+// Plan computes the operations required to synchronize Global and Guild application commands.
+func (s *Syncer) Plan(opts ...Option) (*ChangePlan, error) {
+	return s.PlanContext(context.Background(), opts...)
+}
+
+// PlanContext computes the operations required to synchronize Global and Guild application commands using a context.
+//
+// PlanContext stops sending requests to Discord when the context is canceled
+// after the request in progress (including its rate limit wait).
+func (s *Syncer) PlanContext(ctx context.Context, opts ...Option) (*ChangePlan, error) {
+	c, err := s.config(opts)
+	if err != nil {
+		return nil, fmt.Errorf("Plan: %w", err)
+	}
+
+	plan, err := planApplicationCommands(ctx, c, false)
+	if plan == nil {
+		return nil, fmt.Errorf("Plan: %w", err)
+	}
+
+	if err := mergeErrors(err, checkDeletions(c, plan)); err != nil {
+		return plan, fmt.Errorf("Plan: %w", err)
+	}
+
+	return plan, nil
+}
+
+// PlanGlobalApplicationCommands computes the operations required to synchronize Global application commands.
+func (s *Syncer) PlanGlobalApplicationCommands(opts ...Option) (*ChangePlan, error) {
+	return s.PlanGlobalApplicationCommandsContext(context.Background(), opts...)
+}
+
+// PlanGlobalApplicationCommandsContext computes the operations required to synchronize Global application commands using a context.
+func (s *Syncer) PlanGlobalApplicationCommandsContext(ctx context.Context, opts ...Option) (*ChangePlan, error) {
+	c, err := s.config(opts)
+	if err != nil {
+		return nil, fmt.Errorf("PlanGlobalApplicationCommands: %w", err)
+	}
+
+	plan, err := planGlobalApplicationCommands(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("PlanGlobalApplicationCommands: %w", err)
+	}
+
+	if err := checkDeletions(c, plan); err != nil {
+		return plan, fmt.Errorf("PlanGlobalApplicationCommands: %w", err)
+	}
+
+	return plan, nil
+}
+
+// PlanGuildApplicationCommands computes the operations required to synchronize Guild application commands.
+//
+// The guilds which are synchronized are discovered using the WithGuildDiscovery option,
+// then filtered using the WithGuildPolicy option. An *UnreachableGuildError is returned when guild application commands are defined
+// for guilds the bot is not in, unless the WarnUnreachableGuilds option is used.
+func (s *Syncer) PlanGuildApplicationCommands(opts ...Option) (*ChangePlan, error) {
+	return s.PlanGuildApplicationCommandsContext(context.Background(), opts...)
+}
+
+// PlanGuildApplicationCommandsContext computes the operations required to synchronize Guild application commands using a context.
+//
+// When the ContinueOnError option is used, the plan of every guild which is planned
+// is returned with an *AggregateError containing the guilds which are not.
+//
+// A Gateway session used to discover guilds is disconnected when the context is canceled.
+func (s *Syncer) PlanGuildApplicationCommandsContext(ctx context.Context, opts ...Option) (*ChangePlan, error) {
+	c, err := s.config(opts)
+	if err != nil {
+		return nil, fmt.Errorf("PlanGuildApplicationCommands: %w", err)
+	}
+
+	plan, err := planGuildApplicationCommands(ctx, c, false)
+	if err != nil {
+		return plan, fmt.Errorf("PlanGuildApplicationCommands: %w", err)
+	}
+
+	if err := checkDeletions(c, plan); err != nil {
+		return plan, fmt.Errorf("PlanGuildApplicationCommands: %w", err)
+	}
+
+	return plan, nil
+}
+
+// planApplicationCommands computes the operations required to synchronize the Global and Guild application commands of a configuration.
+//
+// The destroy parameter represents whether the current application commands are deleted
+// instead of synchronized with the defined application commands.
+//
+// When the ContinueOnError option is used, the plan of every scope which is planned
+// is returned with an *AggregateError containing the scopes which are not.
+func planApplicationCommands(ctx context.Context, c *Config, destroy bool) (*ChangePlan, error) {
+	global := c
+	if destroy {
+		global = new(Config)
+		*global = *c
+		global.GlobalApplicationCommands = nil
+	}
+
+	var errs []*OperationError
+
+	plan, err := planGlobalApplicationCommands(ctx, global)
+	if err != nil {
+		if !c.ContinueOnError || ctx.Err() != nil {
+			return nil, err
+		}
+
+		plan = new(ChangePlan)
+		errs = append(errs, &OperationError{
+			Scope:   ScopeGlobal,
+			GuildID: "",
+			Command: CommandKey{Name: "", Type: 0},
+			Action:  "",
+			Err:     err,
+		})
+	}
+
+	guildPlan, err := planGuildApplicationCommands(ctx, c, destroy)
+	if guildPlan == nil {
+		return nil, mergeErrors(newAggregateError(errs), err)
+	}
+
+	plan.merge(guildPlan)
+
+	// the plan of every scope which is planned is returned with the errors of the scopes which are not.
+	return plan, mergeErrors(newAggregateError(errs), err)
+}
+
+// planGlobalApplicationCommands computes the operations required to synchronize the Global application commands of a configuration.
+func planGlobalApplicationCommands(ctx context.Context, c *Config) (*ChangePlan, error) {
+	// parse the defined command list into a map of keys to application commands.
+	owner, err := newOwnership(c)
+	if err != nil {
+		return nil, err
+	}
+
+	definedCommandMap := make(map[CommandKey]disgo.CreateGlobalApplicationCommand, len(c.GlobalApplicationCommands))
+
+	for _, definedCommand := range c.GlobalApplicationCommands {
+		if definedCommand.Name == "" {
+			return nil, errors.New("cannot define application command with empty name")
+		}
+
+		key := commandKey(definedCommand.Type, definedCommand.Name)
+		if _, ok := definedCommandMap[key]; ok {
+			return nil, fmt.Errorf("more than one %s command exists with name %q", commandTypeName(key.Type), key.Name)
+		}
+
+		if err := owner.define(key); err != nil {
+			return nil, err
+		}
+
+		definedCommandMap[key] = definedCommand
+	}
+
+	// get the bot's current Global Application Command State.
+	currentCommands, err := getApplicationCommands(ctx, c.Client, ScopeGlobal, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// parse the current command list into a map of keys to application commands.
+	currentCommandMap := make(map[CommandKey]disgo.CreateGlobalApplicationCommand, len(currentCommands))
+	currentCommandIDMap := make(map[CommandKey]string, len(currentCommands))
+	currentCommandHandlerMap := make(map[CommandKey]*disgo.Flag)
+
+	for _, currentCommand := range currentCommands {
+		key := commandKey(currentCommand.Type, currentCommand.Name)
+		currentCommandIDMap[key] = currentCommand.ID
+		currentCommandMap[key] = globalApplicationCommand(currentCommand)
+
+		if key.Type == disgo.FlagApplicationCommandTypePRIMARY_ENTRY_POINT {
+			currentCommandHandlerMap[key] = disgo.Pointer(currentCommand.Handler)
+		}
+	}
+
+	plan := &ChangePlan{
+		Operations: nil,
+		States:     []*State{newState(ScopeGlobal, "", currentCommands)},
+	}
+
+	// plan the bot's Global Application Command State.
+	for _, key := range slices.SortedFunc(maps.Keys(definedCommandMap), compareCommandKeys) {
+		definedCommand := definedCommandMap[key]
+
+		operation := &Operation{
+			Global:    &definedCommand,
+			Guild:     nil,
+			Action:    ActionCreate,
+			Scope:     ScopeGlobal,
+			GuildID:   "",
+			Name:      key.Name,
+			Type:      key.Type,
+			CommandID: "",
+			Handler:   nil,
+			Diff:      nil,
+		}
+
+		if key.Type == disgo.FlagApplicationCommandTypePRIMARY_ENTRY_POINT {
+			operation.Handler = c.EntryPointHandler
+		}
+
+		// definedCommand key exists on Discord
+		if currentCommand, ok := currentCommandMap[key]; ok {
+			if err := owner.edit(key, currentCommandIDMap[key]); err != nil {
+				return nil, err
+			}
+
+			operation.CommandID = currentCommandIDMap[key]
+			operation.Action = ActionNoOp
+
+			currentHandler := currentCommandHandlerMap[key]
+			if operation.Handler == nil {
+				operation.Handler = currentHandler
+			}
+
+			// but is not equal to Discord's version, so update it.
+			if !c.Equal(definedCommand, currentCommand) {
+				operation.Action = ActionUpdate
+				operation.Diff = DiffGlobalApplicationCommands(
+					NormalizeGlobalApplicationCommand(currentCommand),
+					NormalizeGlobalApplicationCommand(definedCommand),
+				)
+
+				// explain the update when Equal does not normalize application commands.
+				if len(operation.Diff) == 0 {
+					operation.Diff = DiffGlobalApplicationCommands(currentCommand, definedCommand)
+				}
+			}
+
+			// or its entry point handler is not the defined handler, so update it.
+			if currentHandler != nil && *operation.Handler != *currentHandler {
+				operation.Action = ActionUpdate
+				operation.Diff = append(operation.Diff, Change{From: *currentHandler, To: *operation.Handler, Path: "handler"})
+			}
+
+			delete(currentCommandMap, key)
+		}
+
+		plan.Operations = append(plan.Operations, operation)
+	}
+
+	// delete existing current application commands that aren't defined.
+	for _, key := range slices.SortedFunc(maps.Keys(currentCommandMap), compareCommandKeys) {
+		// unless the application command is not owned, so keep it.
+		if !owner.owns(key, currentCommandIDMap[key]) {
+			currentCommand := currentCommandMap[key]
+
+			operation := &Operation{
+				Global:    &currentCommand,
+				Guild:     nil,
+				Action:    ActionUnmanaged,
+				Scope:     ScopeGlobal,
+				GuildID:   "",
+				Name:      key.Name,
+				Type:      key.Type,
+				CommandID: currentCommandIDMap[key],
+				Handler:   currentCommandHandlerMap[key],
+				Diff:      nil,
+			}
+
+			plan.Operations = append(plan.Operations, operation)
+			logUnmanaged(c, operation)
+
+			continue
+		}
+
+		// unless the application command's type is redefined, so recreate it.
+		if operation := recreation(plan.Operations, currentCommandMap, key.Name); operation != nil {
+			operation.Action = ActionRecreate
+			operation.CommandID = currentCommandIDMap[key]
+			operation.Diff = DiffGlobalApplicationCommands(
+				NormalizeGlobalApplicationCommand(currentCommandMap[key]),
+				NormalizeGlobalApplicationCommand(*operation.Global),
+			)
+
+			continue
+		}
+
+		plan.Operations = append(plan.Operations, &Operation{
+			Global:    nil,
+			Guild:     nil,
+			Action:    ActionDelete,
+			Scope:     ScopeGlobal,
+			GuildID:   "",
+			Name:      key.Name,
+			Type:      key.Type,
+			CommandID: currentCommandIDMap[key],
+			Handler:   nil,
+			Diff:      nil,
+		})
+	}
+
+	return plan, nil
+}
+
+// planGuildApplicationCommands computes the operations required to synchronize the Guild application commands of a configuration.
+//
+// The destroy parameter represents whether the current application commands of the managed guilds are deleted
+// instead of synchronized with the defined guild application commands.
+func planGuildApplicationCommands(ctx context.Context, c *Config, destroy bool) (*ChangePlan, error) {
+	// parse the defined guild command list into a map of GuildIDs to a map of keys to guild application commands.
+	definedCommandGuildIDMap := make(map[string]map[CommandKey]disgo.CreateGuildApplicationCommand)
+
+	for _, definedCommand := range c.GuildApplicationCommands {
+		if err := defineGuildApplicationCommand(definedCommandGuildIDMap, definedCommand); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := defineStaticTemplates(c, definedCommandGuildIDMap); err != nil {
+		return nil, err
+	}
+
+	guildIDs, err := discoverGuildIDs(ctx, c, definedCommandGuildIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	discoveredGuildIDs := guildIDs
+
+	// templates are only created in the guilds of the guild allowlist.
+	selectableGuildIDs := guildIDs
+	if c.GuildPolicy == GuildPolicyAllowlist {
+		selectableGuildIDs = slices.DeleteFunc(slices.Clone(guildIDs), func(guildID string) bool {
+			return !slices.Contains(c.ManagedGuildIDs, guildID)
+		})
+	}
+
+	if err := defineSelectedTemplates(ctx, c, selectableGuildIDs, definedCommandGuildIDMap); err != nil {
+		return nil, err
+	}
+
+	owner, err := newOwnership(c)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, guildID := range slices.Sorted(maps.Keys(definedCommandGuildIDMap)) {
+		for _, key := range slices.SortedFunc(maps.Keys(definedCommandGuildIDMap[guildID]), compareCommandKeys) {
+			if err := owner.define(key); err != nil {
+				return nil, fmt.Errorf("guild %q: %w", guildID, err)
+			}
+		}
+	}
+
+	// defined guild application commands for guilds the bot is not in are never synchronized.
+	if unreachable := unreachableGuilds(guildIDs, definedCommandGuildIDMap); unreachable != nil {
+		if !c.WarnUnreachableGuilds {
+			return nil, unreachable
+		}
+
+		for _, guild := range unreachable.Guilds {
+			c.Logger.Warn("guild application commands are defined for a guild the bot is not in",
+				slog.String(logKeyScope, string(ScopeGuild)),
+				slog.String(logKeyGuildID, guild.GuildID),
+				slog.Int(logKeyCount, len(guild.Commands)),
+			)
+		}
+	}
+
+	guildIDs, err = managedGuildIDs(guildIDs, c.GuildPolicy, c.ManagedGuildIDs, definedCommandGuildIDMap)
+	if err != nil {
+		return nil, err
+	}
+
+	// discovered guilds which are not declared can contain the application commands of templates they stopped matching,
+	// so only the application commands of templates are deleted from those guilds.
+	templateGuildIDs := make(map[string]bool)
+
+	if c.GuildPolicy == GuildPolicyDeclared && len(c.GuildApplicationCommandTemplates) != 0 {
+		for _, guildID := range discoveredGuildIDs {
+			if !slices.Contains(guildIDs, guildID) {
+				templateGuildIDs[guildID] = true
+				guildIDs = append(guildIDs, guildID)
+			}
+		}
+	}
+
+	templateKeys := templateCommandKeys(c.GuildApplicationCommandTemplates)
+
+	plan := new(ChangePlan)
+
+	var errs []*OperationError
+
+	// the defined guild application commands only determine the guilds which are destroyed.
+	if destroy {
+		clear(definedCommandGuildIDMap)
+	}
+
+	for _, guildID := range guildIDs {
+		owns := owner.owns
+		if templateGuildIDs[guildID] {
+			owns = func(key CommandKey, commandID string) bool {
+				return templateKeys[key] && owner.owns(key, commandID)
+			}
+		}
+
+		guildPlan, err := planGuildIDApplicationCommands(ctx, c, guildID, definedCommandGuildIDMap[guildID], owner, owns)
+		if err != nil {
+			if !c.ContinueOnError || ctx.Err() != nil {
+				return nil, err
+			}
+
+			errs = append(errs, &OperationError{
+				Scope:   ScopeGuild,
+				GuildID: guildID,
+				Command: CommandKey{Name: "", Type: 0},
+				Action:  "",
+				Err:     err,
+			})
+
+			continue
+		}
+
+		plan.merge(guildPlan)
+	}
+
+	// the plan of every guild which is planned is returned with the errors of the guilds which are not.
+	if err := newAggregateError(errs); err != nil {
+		return plan, err
+	}
+
+	return plan, nil
+}
+
+// planGuildIDApplicationCommands computes the operations required to synchronize the application commands of a guild.
+//
+// The owns parameter returns whether a current application command which is not defined can be deleted.
+func planGuildIDApplicationCommands(ctx context.Context, c *Config, guildID string, definedCommandMap map[CommandKey]disgo.CreateGuildApplicationCommand, owner *ownership, owns func(key CommandKey, commandID string) bool) (*ChangePlan, error) {
+	// get the bot's current Guild Application Command State.
+	currentCommands, err := getApplicationCommands(ctx, c.Client, ScopeGuild, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse the current guild command list into a map of keys to application commands.
+	currentCommandMap := make(map[CommandKey]disgo.CreateGuildApplicationCommand, len(currentCommands))
+	currentCommandIDMap := make(map[CommandKey]string, len(currentCommands))
+
+	for _, currentCommand := range currentCommands {
+		key := commandKey(currentCommand.Type, currentCommand.Name)
+		currentCommandIDMap[key] = currentCommand.ID
+		currentCommandMap[key] = guildApplicationCommand(guildID, currentCommand)
+	}
+
+	plan := &ChangePlan{
+		Operations: nil,
+		States:     []*State{newState(ScopeGuild, guildID, currentCommands)},
+	}
+
+	// plan the bot's Guild Application Command State.
+	for _, key := range slices.SortedFunc(maps.Keys(definedCommandMap), compareCommandKeys) {
+		definedCommand := definedCommandMap[key]
+
+		operation := &Operation{
+			Global:    nil,
+			Guild:     &definedCommand,
+			Action:    ActionCreate,
+			Scope:     ScopeGuild,
+			GuildID:   guildID,
+			Name:      key.Name,
+			Type:      key.Type,
+			CommandID: "",
+			Handler:   nil,
+			Diff:      nil,
+		}
+
+		// definedCommand key exists on Discord
+		if currentCommand, ok := currentCommandMap[key]; ok {
+			if err := owner.edit(key, currentCommandIDMap[key]); err != nil {
+				return nil, fmt.Errorf("guild %q: %w", guildID, err)
+			}
+
+			operation.CommandID = currentCommandIDMap[key]
+			operation.Action = ActionNoOp
+
+			// but is not equal to Discord's version, so update it.
+			if !c.Equal(definedCommand, currentCommand) {
+				operation.Action = ActionUpdate
+				operation.Diff = DiffGuildApplicationCommands(
+					NormalizeGuildApplicationCommand(currentCommand),
+					NormalizeGuildApplicationCommand(definedCommand),
+				)
+
+				// explain the update when Equal does not normalize application commands.
+				if len(operation.Diff) == 0 {
+					operation.Diff = DiffGuildApplicationCommands(currentCommand, definedCommand)
+				}
+			}
+
+			delete(currentCommandMap, key)
+		}
+
+		plan.Operations = append(plan.Operations, operation)
+	}
+
+	// delete existing current guild application commands that aren't defined.
+	for _, key := range slices.SortedFunc(maps.Keys(currentCommandMap), compareCommandKeys) {
+		// unless the guild application command is not owned, so keep it.
+		if !owns(key, currentCommandIDMap[key]) {
+			currentCommand := currentCommandMap[key]
+
+			operation := &Operation{
+				Global:    nil,
+				Guild:     &currentCommand,
+				Action:    ActionUnmanaged,
+				Scope:     ScopeGuild,
+				GuildID:   guildID,
+				Name:      key.Name,
+				Type:      key.Type,
+				CommandID: currentCommandIDMap[key],
+				Handler:   nil,
+				Diff:      nil,
+			}
+
+			plan.Operations = append(plan.Operations, operation)
+			logUnmanaged(c, operation)
+
+			continue
+		}
+
+		// unless the guild application command's type is redefined, so recreate it.
+		if operation := recreation(plan.Operations, currentCommandMap, key.Name); operation != nil {
+			operation.Action = ActionRecreate
+			operation.CommandID = currentCommandIDMap[key]
+			operation.Diff = DiffGuildApplicationCommands(
+				NormalizeGuildApplicationCommand(currentCommandMap[key]),
+				NormalizeGuildApplicationCommand(*operation.Guild),
+			)
+
+			continue
+		}
+
+		plan.Operations = append(plan.Operations, &Operation{
+			Global:    nil,
+			Guild:     nil,
+			Action:    ActionDelete,
+			Scope:     ScopeGuild,
+			GuildID:   guildID,
+			Name:      key.Name,
+			Type:      key.Type,
+			CommandID: currentCommandIDMap[key],
+			Handler:   nil,
+			Diff:      nil,
+		})
+	}
+
+	return plan, nil
+}
+
+// recreation returns the create operation which recreates an undefined current application command with a name.
+//
+// recreation returns nil unless exactly one create operation and
+// exactly one undefined current application command share the name.
+func recreation[V any](operations []*Operation, currentCommandMap map[CommandKey]V, name string) *Operation {
+	var created *Operation
+
+	for _, operation := range operations {
+		if operation.Action == ActionCreate && operation.Name == name {
+			if created != nil {
+				return nil
+			}
+
+			created = operation
+		}
+	}
+
+	count := 0
+
+	for key := range currentCommandMap {
+		if key.Name == name {
+			count++
+		}
+	}
+
+	if count != 1 {
+		return nil
+	}
+
+	return created
+}
+
+// getApplicationCommands gets the current application commands of a scope.
+func getApplicationCommands(ctx context.Context, bot *disgo.Client, scope Scope, guildID string) ([]*disgo.ApplicationCommand, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if scope == ScopeGuild {
+		getGuildApplicatonCommands := &disgo.GetGuildApplicationCommands{
+			WithLocalizations: disgo.Pointer(true),
+			GuildID:           guildID,
+		}
+
+		currentCommands, err := getGuildApplicatonCommands.Send(bot)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get current guild %q application commands: %w", guildID, err)
+		}
+
+		return currentCommands, nil
+	}
+
+	getGlobalApplicatonCommands := &disgo.GetGlobalApplicationCommands{
+		WithLocalizations: disgo.Pointer(true),
+	}
+
+	currentCommands, err := getGlobalApplicatonCommands.Send(bot)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get current application commands: %w", err)
+	}
+
+	return currentCommands, nil
+}
+
+// newState returns the state of a scope's current application commands.
+func newState(scope Scope, guildID string, currentCommands []*disgo.ApplicationCommand) *State {
+	state := &State{
+		Versions: make(map[string]string, len(currentCommands)),
+		Scope:    scope,
+		GuildID:  guildID,
+	}
+
+	for _, currentCommand := range currentCommands {
+		state.Versions[currentCommand.ID] = currentCommand.Version
+	}
+
+	return state
+}
+
+// globalApplicationCommand converts a current application command into a global application command definition.
+func globalApplicationCommand(currentCommand *disgo.ApplicationCommand) disgo.CreateGlobalApplicationCommand {
+	command := disgo.CreateGlobalApplicationCommand{
+		NameLocalizations:        currentCommand.NameLocalizations,
+		Description:              &currentCommand.Description,
+		DescriptionLocalizations: currentCommand.DescriptionLocalizations,
+		DefaultMemberPermissions: nil,
+		Type:                     currentCommand.Type,
+		NSFW:                     currentCommand.NSFW,
+		Name:                     currentCommand.Name,
+		Options:                  currentCommand.Options,
+		IntegrationTypes:         currentCommand.IntegrationTypes,
+		Contexts:                 nil,
+	}
+
+	if currentCommand.DefaultMemberPermissions != nil {
+		command.DefaultMemberPermissions = &currentCommand.DefaultMemberPermissions
+	}
+
+	if currentCommand.Contexts != nil {
+		command.Contexts = *currentCommand.Contexts
+	}
+
+	return command
+}
+
+// guildApplicationCommand converts a current application command into a guild application command definition.
+func guildApplicationCommand(guildID string, currentCommand *disgo.ApplicationCommand) disgo.CreateGuildApplicationCommand {
+	return disgo.CreateGuildApplicationCommand{
+		NameLocalizations:        currentCommand.NameLocalizations,
+		Description:              &currentCommand.Description,
+		DescriptionLocalizations: currentCommand.DescriptionLocalizations,
+		DefaultMemberPermissions: &currentCommand.DefaultMemberPermissions,
+		Type:                     currentCommand.Type,
+		NSFW:                     currentCommand.NSFW,
+		GuildID:                  guildID,
+		Name:                     currentCommand.Name,
+		Options:                  currentCommand.Options,
+	}
+}
@@ -0,0 +1,338 @@
+package disgoform
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/rs/xid"
+	"github.com/switchupcb/disgo"
+)
+
+// createGlobalApplicationCommand represents a Create Global Application Command request
+// which sends the entry point handler of a PRIMARY_ENTRY_POINT application command.
+//
+// https://discord.com/developers/docs/interactions/application-commands#create-global-application-command
+type createGlobalApplicationCommand struct {
+	disgo.CreateGlobalApplicationCommand
+	Handler *disgo.Flag `json:"handler,omitempty"`
+}
+
+// Send sends a createGlobalApplicationCommand request to Discord and returns an ApplicationCommand.
+func (r *createGlobalApplicationCommand) Send(bot *disgo.Client) (*disgo.ApplicationCommand, error) {
+	routeid, resourceid := disgo.RateLimitHashFuncs[3]("3")
+	endpoint := disgo.EndpointCreateGlobalApplicationCommand(bot.ApplicationID)
+
+	result := new(disgo.ApplicationCommand)
+	if err := send(bot, routeid, resourceid, http.MethodPost, endpoint, r, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// editGlobalApplicationCommand represents an Edit Global Application Command request
+// which sends every mutable field of a global application command.
+//
+// https://discord.com/developers/docs/interactions/application-commands#edit-global-application-command
+type editGlobalApplicationCommand struct {
+	CommandID                string                            `json:"-"`
+	Name                     string                            `json:"name"`
+	NameLocalizations        *map[string]string                `json:"name_localizations"`
+	Description              *string                           `json:"description"`
+	DescriptionLocalizations *map[string]string                `json:"description_localizations"`
+	Options                  []*disgo.ApplicationCommandOption `json:"options"`
+	DefaultMemberPermissions *string                           `json:"default_member_permissions"`
+	IntegrationTypes         []disgo.Flag                      `json:"integration_types"`
+	Contexts                 []disgo.Flag                      `json:"contexts"`
+	NSFW                     *bool                             `json:"nsfw"`
+	Handler                  *disgo.Flag                       `json:"handler,omitempty"`
+}
+
+// newEditGlobalApplicationCommand returns a request which edits a current global application command
+// to match a defined global application command.
+func newEditGlobalApplicationCommand(commandID string, definedCommand disgo.CreateGlobalApplicationCommand) *editGlobalApplicationCommand {
+	definedCommand = NormalizeGlobalApplicationCommand(definedCommand)
+
+	request := &editGlobalApplicationCommand{
+		CommandID:                commandID,
+		Name:                     definedCommand.Name,
+		NameLocalizations:        definedCommand.NameLocalizations,
+		Description:              definedCommand.Description,
+		DescriptionLocalizations: definedCommand.DescriptionLocalizations,
+		Options:                  definedCommand.Options,
+		DefaultMemberPermissions: *definedCommand.DefaultMemberPermissions,
+		IntegrationTypes:         definedCommand.IntegrationTypes,
+		Contexts:                 definedCommand.Contexts,
+		NSFW:                     definedCommand.NSFW,
+		Handler:                  nil,
+	}
+
+	// an empty option list removes the options of the current application command.
+	if request.Options == nil {
+		request.Options = []*disgo.ApplicationCommandOption{}
+	}
+
+	return request
+}
+
+// Send sends an editGlobalApplicationCommand request to Discord and returns an ApplicationCommand.
+func (r *editGlobalApplicationCommand) Send(bot *disgo.Client) (*disgo.ApplicationCommand, error) {
+	routeid, resourceid := disgo.RateLimitHashFuncs[5]("5", "297ffb1f"+r.CommandID)
+	endpoint := disgo.EndpointEditGlobalApplicationCommand(bot.ApplicationID, r.CommandID)
+
+	result := new(disgo.ApplicationCommand)
+	if err := send(bot, routeid, resourceid, http.MethodPatch, endpoint, r, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// editGuildApplicationCommand represents an Edit Guild Application Command request
+// which sends every mutable field of a guild application command.
+//
+// https://discord.com/developers/docs/interactions/application-commands#edit-guild-application-command
+type editGuildApplicationCommand struct {
+	GuildID                  string                            `json:"-"`
+	CommandID                string                            `json:"-"`
+	Name                     string                            `json:"name"`
+	NameLocalizations        *map[string]string                `json:"name_localizations"`
+	Description              *string                           `json:"description"`
+	DescriptionLocalizations *map[string]string                `json:"description_localizations"`
+	Options                  []*disgo.ApplicationCommandOption `json:"options"`
+	DefaultMemberPermissions *string                           `json:"default_member_permissions"`
+	NSFW                     *bool                             `json:"nsfw"`
+}
+
+// newEditGuildApplicationCommand returns a request which edits a current guild application command
+// to match a defined guild application command.
+func newEditGuildApplicationCommand(commandID string, definedCommand disgo.CreateGuildApplicationCommand) *editGuildApplicationCommand {
+	definedCommand = NormalizeGuildApplicationCommand(definedCommand)
+
+	request := &editGuildApplicationCommand{
+		GuildID:                  definedCommand.GuildID,
+		CommandID:                commandID,
+		Name:                     definedCommand.Name,
+		NameLocalizations:        definedCommand.NameLocalizations,
+		Description:              definedCommand.Description,
+		DescriptionLocalizations: definedCommand.DescriptionLocalizations,
+		Options:                  definedCommand.Options,
+		DefaultMemberPermissions: *definedCommand.DefaultMemberPermissions,
+		NSFW:                     definedCommand.NSFW,
+	}
+
+	// an empty option list removes the options of the current application command.
+	if request.Options == nil {
+		request.Options = []*disgo.ApplicationCommandOption{}
+	}
+
+	return request
+}
+
+// Send sends an editGuildApplicationCommand request to Discord and returns an ApplicationCommand.
+func (r *editGuildApplicationCommand) Send(bot *disgo.Client) (*disgo.ApplicationCommand, error) {
+	routeid, resourceid := disgo.RateLimitHashFuncs[11]("11", "45892a5d"+r.GuildID, "297ffb1f"+r.CommandID)
+	endpoint := disgo.EndpointEditGuildApplicationCommand(bot.ApplicationID, r.GuildID, r.CommandID)
+
+	result := new(disgo.ApplicationCommand)
+	if err := send(bot, routeid, resourceid, http.MethodPatch, endpoint, r, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// bulkApplicationCommand represents an application command of a Bulk Overwrite Application Commands request.
+//
+// An application command with an ID updates the existing application command with that ID.
+type bulkApplicationCommand struct {
+	ID                       string                            `json:"id,omitempty"`
+	Name                     string                            `json:"name"`
+	NameLocalizations        *map[string]string                `json:"name_localizations"`
+	Description              *string                           `json:"description"`
+	DescriptionLocalizations *map[string]string                `json:"description_localizations"`
+	Options                  []*disgo.ApplicationCommandOption `json:"options,omitempty"`
+	DefaultMemberPermissions *string                           `json:"default_member_permissions"`
+	IntegrationTypes         []disgo.Flag                      `json:"integration_types,omitempty"`
+	Contexts                 []disgo.Flag                      `json:"contexts,omitempty"`
+	Type                     *disgo.Flag                       `json:"type"`
+	NSFW                     *bool                             `json:"nsfw"`
+	Handler                  *disgo.Flag                       `json:"handler,omitempty"`
+}
+
+// newBulkGlobalApplicationCommand returns the bulk application command of a defined global application command.
+func newBulkGlobalApplicationCommand(commandID string, definedCommand disgo.CreateGlobalApplicationCommand) *bulkApplicationCommand {
+	definedCommand = NormalizeGlobalApplicationCommand(definedCommand)
+
+	return &bulkApplicationCommand{
+		ID:                       commandID,
+		Name:                     definedCommand.Name,
+		NameLocalizations:        definedCommand.NameLocalizations,
+		Description:              definedCommand.Description,
+		DescriptionLocalizations: definedCommand.DescriptionLocalizations,
+		Options:                  definedCommand.Options,
+		DefaultMemberPermissions: *definedCommand.DefaultMemberPermissions,
+		IntegrationTypes:         definedCommand.IntegrationTypes,
+		Contexts:                 definedCommand.Contexts,
+		Type:                     definedCommand.Type,
+		NSFW:                     definedCommand.NSFW,
+		Handler:                  nil,
+	}
+}
+
+// newBulkGuildApplicationCommand returns the bulk application command of a defined guild application command.
+func newBulkGuildApplicationCommand(commandID string, definedCommand disgo.CreateGuildApplicationCommand) *bulkApplicationCommand {
+	definedCommand = NormalizeGuildApplicationCommand(definedCommand)
+
+	return &bulkApplicationCommand{
+		ID:                       commandID,
+		Name:                     definedCommand.Name,
+		NameLocalizations:        definedCommand.NameLocalizations,
+		Description:              definedCommand.Description,
+		DescriptionLocalizations: definedCommand.DescriptionLocalizations,
+		Options:                  definedCommand.Options,
+		DefaultMemberPermissions: *definedCommand.DefaultMemberPermissions,
+		IntegrationTypes:         nil,
+		Contexts:                 nil,
+		Type:                     definedCommand.Type,
+		NSFW:                     definedCommand.NSFW,
+		Handler:                  nil,
+	}
+}
+
+// bulkOverwriteApplicationCommands represents a Bulk Overwrite Global Application Commands request
+// or a Bulk Overwrite Guild Application Commands request (when GuildID is set).
+//
+// https://discord.com/developers/docs/interactions/application-commands#bulk-overwrite-global-application-commands
+// https://discord.com/developers/docs/interactions/application-commands#bulk-overwrite-guild-application-commands
+type bulkOverwriteApplicationCommands struct {
+	GuildID             string
+	ApplicationCommands []*bulkApplicationCommand
+}
+
+// Send sends a bulkOverwriteApplicationCommands request to Discord and returns the resulting ApplicationCommands.
+func (r *bulkOverwriteApplicationCommands) Send(bot *disgo.Client) ([]*disgo.ApplicationCommand, error) {
+	routeid, resourceid := disgo.RateLimitHashFuncs[7]("7")
+	endpoint := disgo.EndpointBulkOverwriteGlobalApplicationCommands(bot.ApplicationID)
+
+	if r.GuildID != "" {
+		routeid, resourceid = disgo.RateLimitHashFuncs[13]("13", "45892a5d"+r.GuildID)
+		endpoint = disgo.EndpointBulkOverwriteGuildApplicationCommands(bot.ApplicationID, r.GuildID)
+	}
+
+	// an empty list deletes every application command.
+	commands := r.ApplicationCommands
+	if commands == nil {
+		commands = []*bulkApplicationCommand{}
+	}
+
+	var result []*disgo.ApplicationCommand
+	if err := send(bot, routeid, resourceid, http.MethodPut, endpoint, commands, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// send sends a request with a JSON body to Discord.
+func send(bot *disgo.Client, routeid, resourceid, method, endpoint string, request, result any) error {
+	xid := xid.New().String()
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return disgo.ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           fmt.Errorf("an error occurred while sending a request: marshal: %w", err),
+		}
+	}
+
+	if err := disgo.SendRequest(bot, xid, routeid, resourceid, method, endpoint, disgo.ContentTypeJSON, body, result); err != nil {
+		return disgo.ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return nil
+}
+
+// getGuildApplicationCommandPermissions represents a Get Guild Application Command Permissions request.
+//
+// disgo.GetGuildApplicationCommandPermissions decodes a single object, while Discord responds with an array.
+type getGuildApplicationCommandPermissions struct {
+	GuildID string
+}
+
+// Send sends a getGuildApplicationCommandPermissions request to Discord and returns the permissions of each application command.
+func (r *getGuildApplicationCommandPermissions) Send(bot *disgo.Client) ([]*disgo.GuildApplicationCommandPermissions, error) {
+	xid := xid.New().String()
+	routeid, resourceid := disgo.RateLimitHashFuncs[14]("14", "45892a5d"+r.GuildID)
+	endpoint := disgo.EndpointGetGuildApplicationCommandPermissions(bot.ApplicationID, r.GuildID)
+
+	var result []*disgo.GuildApplicationCommandPermissions
+	if err := disgo.SendRequest(bot, xid, routeid, resourceid, http.MethodGet, endpoint, nil, nil, &result); err != nil {
+		return nil, disgo.ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
+
+// getCurrentUserGuilds represents a Get Current User Guilds request.
+//
+// disgo.GetCurrentUserGuilds sends its parameters in the request body, while Discord reads them from the query string.
+//
+// https://discord.com/developers/docs/resources/user#get-current-user-guilds
+type getCurrentUserGuilds struct {
+	// After represents the ID of the guild which the returned guilds are after (none when empty).
+	After string
+
+	// Limit represents the maximum amount of guilds returned.
+	Limit int
+}
+
+// Send sends a getCurrentUserGuilds request to Discord and returns the guilds of the current user.
+func (r *getCurrentUserGuilds) Send(bot *disgo.Client) ([]*disgo.Guild, error) {
+	xid := xid.New().String()
+	routeid, resourceid := disgo.RateLimitHashFuncs[180]("180")
+
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(r.Limit))
+
+	if r.After != "" {
+		query.Set("after", r.After)
+	}
+
+	endpoint := disgo.EndpointGetCurrentUserGuilds() + "?" + query.Encode()
+
+	var result []*disgo.Guild
+	if err := disgo.SendRequest(bot, xid, routeid, resourceid, http.MethodGet, endpoint, nil, nil, &result); err != nil {
+		return nil, disgo.ErrorRequest{
+			ClientID:      bot.ApplicationID,
+			CorrelationID: xid,
+			RouteID:       routeid,
+			ResourceID:    resourceid,
+			Endpoint:      endpoint,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
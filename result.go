@@ -0,0 +1,224 @@
+package disgoform
+
+import (
+	"strings"
+
+	"github.com/switchupcb/disgo"
+)
+
+// Result represents the result of a synchronization.
+type Result struct {
+	// Operations represents the create, update, and delete operations sent to Discord.
+	//
+	// In a dry run, Operations represents the operations that would have been sent to Discord.
+	//
+	// When a synchronization fails or is canceled, Operations represents the operations
+	// which were sent to Discord prior to the failure.
+	Operations []*Operation `json:"-"`
+
+	// Scopes represents the application commands of each synchronized scope (in order of synchronization).
+	Scopes []*ScopeResult `json:"scopes"`
+
+	// Permissions represents the synchronized application command permissions.
+	Permissions []*PermissionsResult `json:"permissions,omitempty"`
+
+	// DryRun represents whether the synchronization is a dry run.
+	DryRun bool `json:"dry_run"`
+}
+
+// ScopeResult represents the application commands of a synchronized scope.
+type ScopeResult struct {
+	// Scope represents the scope of the application commands.
+	Scope Scope `json:"scope"`
+
+	// GuildID represents the guild of guild application commands.
+	GuildID string `json:"guild_id,omitempty"`
+
+	// Created represents the created application commands.
+	Created []*CommandResult `json:"created,omitempty"`
+
+	// Updated represents the updated application commands.
+	Updated []*CommandResult `json:"updated,omitempty"`
+
+	// Recreated represents the application commands which are deleted and created to change their type.
+	Recreated []*CommandResult `json:"recreated,omitempty"`
+
+	// Deleted represents the deleted application commands.
+	Deleted []*CommandResult `json:"deleted,omitempty"`
+
+	// Unchanged represents the application commands which are not changed.
+	Unchanged []*CommandResult `json:"unchanged,omitempty"`
+
+	// Unmanaged represents the current application commands which are not changed because they're not owned.
+	Unmanaged []*CommandResult `json:"unmanaged,omitempty"`
+}
+
+// CommandResult represents a synchronized application command.
+type CommandResult struct {
+	// Name represents the name of the application command.
+	Name string `json:"name"`
+
+	// Type represents the type of the application command (e.g., CHAT_INPUT).
+	Type string `json:"type"`
+
+	// ID represents the Discord-assigned ID of the application command.
+	//
+	// ID is empty for an application command which is created in a dry run.
+	ID string `json:"id,omitempty"`
+
+	// Version represents the Discord-assigned version of the application command.
+	//
+	// The version of a deleted application command is its version prior to deletion.
+	Version string `json:"version,omitempty"`
+
+	// Fields represents the top-level fields which are changed (update, recreate).
+	Fields []string `json:"fields,omitempty"`
+}
+
+// scopeKey represents the key of a scope.
+type scopeKey struct {
+	scope   Scope
+	guildID string
+}
+
+// planned returns the operations of a plan as planned executions.
+func planned(plan *ChangePlan) []execution {
+	executions := make([]execution, len(plan.Operations))
+	for i, operation := range plan.Operations {
+		executions[i] = execution{operation: operation, command: nil}
+	}
+
+	return executions
+}
+
+// newResult returns the result of a synchronization from its plan and executed operations.
+func newResult(plan *ChangePlan, executions []execution, dryRun bool) *Result {
+	result := &Result{
+		Operations:  nil,
+		Scopes:      nil,
+		Permissions: nil,
+		DryRun:      dryRun,
+	}
+
+	if plan == nil {
+		return result
+	}
+
+	scopes := make(map[scopeKey]*ScopeResult, len(plan.States))
+	versions := make(map[string]string)
+
+	for _, state := range plan.States {
+		scope := &ScopeResult{ //nolint:exhaustruct
+			Scope:   state.Scope,
+			GuildID: state.GuildID,
+		}
+
+		scopes[scopeKey{scope: state.Scope, guildID: state.GuildID}] = scope
+		result.Scopes = append(result.Scopes, scope)
+
+		for commandID, version := range state.Versions {
+			versions[commandID] = version
+		}
+	}
+
+	// unchanged and unmanaged application commands are never executed.
+	for _, operation := range plan.Operations {
+		scope := scopes[scopeKey{scope: operation.Scope, guildID: operation.GuildID}]
+		if scope == nil {
+			continue
+		}
+
+		switch operation.Action {
+		case ActionNoOp:
+			scope.Unchanged = append(scope.Unchanged, newCommandResult(operation, nil, versions))
+		case ActionUnmanaged:
+			scope.Unmanaged = append(scope.Unmanaged, newCommandResult(operation, nil, versions))
+		case ActionCreate, ActionUpdate, ActionDelete, ActionRecreate:
+		}
+	}
+
+	for _, executed := range executions {
+		operation := executed.operation
+		if !operation.Action.changes() {
+			continue
+		}
+
+		result.Operations = append(result.Operations, operation)
+
+		scope := scopes[scopeKey{scope: operation.Scope, guildID: operation.GuildID}]
+		if scope == nil {
+			continue
+		}
+
+		command := newCommandResult(operation, executed.command, versions)
+
+		switch operation.Action {
+		case ActionCreate:
+			scope.Created = append(scope.Created, command)
+		case ActionUpdate:
+			scope.Updated = append(scope.Updated, command)
+		case ActionRecreate:
+			scope.Recreated = append(scope.Recreated, command)
+		case ActionDelete:
+			scope.Deleted = append(scope.Deleted, command)
+		case ActionNoOp, ActionUnmanaged:
+		}
+	}
+
+	return result
+}
+
+// newCommandResult returns the result of an operation.
+//
+// The ID and version of the application command returned by Discord take precedence
+// over the ID and version of the current application command.
+func newCommandResult(operation *Operation, command *disgo.ApplicationCommand, versions map[string]string) *CommandResult {
+	result := &CommandResult{
+		Name:    operation.Name,
+		Type:    commandTypeName(operation.Type),
+		ID:      "",
+		Version: "",
+		Fields:  operation.Diff.Fields(),
+	}
+
+	// a created application command has no current ID.
+	if operation.Action != ActionCreate && operation.Action != ActionRecreate {
+		result.ID = operation.CommandID
+		result.Version = versions[operation.CommandID]
+	}
+
+	if command != nil {
+		result.ID = command.ID
+		result.Version = command.Version
+	}
+
+	return result
+}
+
+// String returns a human-readable representation of the result.
+func (r *Result) String() string {
+	plan := &ChangePlan{
+		Operations: r.Operations,
+		States:     nil,
+	}
+
+	var b strings.Builder
+
+	b.WriteString(plan.String())
+
+	for _, permissions := range r.Permissions {
+		if permissions.Action != ActionNoOp {
+			b.WriteString(permissions.String())
+			b.WriteByte('\n')
+		}
+	}
+
+	return b.String()
+}
+
+// merge merges a result into the result.
+func (r *Result) merge(result *Result) {
+	r.Operations = append(r.Operations, result.Operations...)
+	r.Scopes = append(r.Scopes, result.Scopes...)
+	r.Permissions = append(r.Permissions, result.Permissions...)
+}
@@ -0,0 +1,475 @@
+package disgoform
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/format"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/switchupcb/disgo"
+)
+
+// SyncConfig returns a Go file which defines the bot's current Global application commands
+// and the current Guild application commands of the given guilds.
+//
+// Use ConfigFile to output a Go file from application command definitions.
+func SyncConfig(bot *disgo.Client, guildIDs []string) (string, error) {
+	definitions, err := importApplicationCommands(context.Background(), bot, guildIDs, false)
+	if err != nil {
+		return "", fmt.Errorf("SyncConfig: %w", err)
+	}
+
+	output, err := ConfigFile(definitions.GlobalApplicationCommands, definitions.GuildApplicationCommands)
+	if err != nil {
+		return "", fmt.Errorf("SyncConfig: %w", err)
+	}
+
+	return output, nil
+}
+
+// Import returns the bot's current Global application commands
+// and the current Guild application commands and permissions of the given guilds.
+//
+// Use DefinitionFile to output a definition file from the returned definitions.
+func Import(bot *disgo.Client, guildIDs []string) (*Definitions, error) {
+	return ImportContext(context.Background(), bot, guildIDs)
+}
+
+// ImportContext returns the bot's current Global application commands
+// and the current Guild application commands and permissions of the given guilds.
+func ImportContext(ctx context.Context, bot *disgo.Client, guildIDs []string) (*Definitions, error) {
+	definitions, err := importApplicationCommands(ctx, bot, guildIDs, true)
+	if err != nil {
+		return nil, fmt.Errorf("Import: %w", err)
+	}
+
+	return definitions, nil
+}
+
+// importApplicationCommands returns the bot's current Global application commands
+// and the current Guild application commands (and permissions) of the given guilds.
+func importApplicationCommands(ctx context.Context, bot *disgo.Client, guildIDs []string, permissions bool) (*Definitions, error) {
+	// get the bot's current Global Application Command State.
+	currentCommands, err := getApplicationCommands(ctx, bot, ScopeGlobal, "")
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := &Definitions{
+		GlobalApplicationCommands:     make([]disgo.CreateGlobalApplicationCommand, 0, len(currentCommands)),
+		GuildApplicationCommands:      nil,
+		ApplicationCommandPermissions: nil,
+	}
+
+	currentGlobalCommands := currentCommands
+
+	for _, currentCommand := range sortApplicationCommands(currentCommands) {
+		definitions.GlobalApplicationCommands = append(definitions.GlobalApplicationCommands, globalApplicationCommand(currentCommand))
+	}
+
+	// get the bot's current Guild Application Command State of each guild.
+	for _, guildID := range guildIDs {
+		currentCommands, err := getApplicationCommands(ctx, bot, ScopeGuild, guildID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, currentCommand := range sortApplicationCommands(currentCommands) {
+			definitions.GuildApplicationCommands = append(definitions.GuildApplicationCommands, guildApplicationCommand(guildID, currentCommand))
+		}
+
+		if !permissions {
+			continue
+		}
+
+		guildPermissions, err := importPermissions(ctx, bot, guildID, slices.Concat(currentGlobalCommands, currentCommands))
+		if err != nil {
+			return nil, err
+		}
+
+		definitions.ApplicationCommandPermissions = append(definitions.ApplicationCommandPermissions, guildPermissions...)
+	}
+
+	return definitions, nil
+}
+
+// importPermissions returns the current application command permissions of a guild.
+func importPermissions(ctx context.Context, bot *disgo.Client, guildID string, currentCommands []*disgo.ApplicationCommand) ([]CommandPermissions, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	getPermissions := &getGuildApplicationCommandPermissions{GuildID: guildID}
+
+	currentPermissions, err := getPermissions.Send(bot)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get current guild %q application command permissions: %w", guildID, err)
+	}
+
+	commands := make(map[string]*disgo.ApplicationCommand, len(currentCommands))
+	for _, command := range currentCommands {
+		commands[command.ID] = command
+	}
+
+	permissions := make([]CommandPermissions, 0, len(currentPermissions))
+
+	for _, current := range currentPermissions {
+		commandPermissions := CommandPermissions{
+			GuildID:     guildID,
+			Name:        "",
+			Type:        nil,
+			Permissions: current.Permissions,
+		}
+
+		// the application-wide permissions use the application ID as a command ID.
+		if current.ID != bot.ApplicationID {
+			command, ok := commands[current.ID]
+			if !ok {
+				continue
+			}
+
+			commandPermissions.Name = command.Name
+			commandPermissions.Type = command.Type
+		}
+
+		permissions = append(permissions, commandPermissions)
+	}
+
+	// the application-wide permissions are sorted first.
+	slices.SortStableFunc(permissions, func(a, b CommandPermissions) int {
+		if a.Name == "" || b.Name == "" {
+			return strings.Compare(a.Name, b.Name)
+		}
+
+		return compareCommandKeys(a.Key(), b.Key())
+	})
+
+	return permissions, nil
+}
+
+// ConfigFile returns a gofmt'ed Go file which defines Global and Guild application commands.
+//
+// The returned file assigns disgoform.GlobalApplicationCommands and disgoform.GuildApplicationCommands
+// in an init function of package main, so it can be compiled alongside a program which calls disgoform.Sync.
+// Fields which equal the default values Discord fills in are omitted.
+func ConfigFile(globalCommands []disgo.CreateGlobalApplicationCommand, guildCommands []disgo.CreateGuildApplicationCommand) (string, error) {
+	var g generator
+
+	g.printf("package main\n\n")
+	g.printf("import (\n%q\n%q\n)\n\n", "github.com/switchupcb/disgo", "github.com/switchupcb/disgoform")
+	g.printf("// init defines the bot's application commands.\n")
+	g.printf("func init() {\n")
+
+	trimmedGlobalCommands := make([]disgo.CreateGlobalApplicationCommand, len(globalCommands))
+	for i, command := range globalCommands {
+		trimmedGlobalCommands[i] = trimGlobalApplicationCommand(command)
+	}
+
+	g.printf("disgoform.GlobalApplicationCommands = ")
+	g.value(reflect.ValueOf(trimmedGlobalCommands), "")
+	g.printf("\n\n")
+
+	trimmedGuildCommands := make([]disgo.CreateGuildApplicationCommand, len(guildCommands))
+	for i, command := range guildCommands {
+		trimmedGuildCommands[i] = trimGuildApplicationCommand(command)
+	}
+
+	g.printf("disgoform.GuildApplicationCommands = ")
+	g.value(reflect.ValueOf(trimmedGuildCommands), "")
+	g.printf("\n}\n")
+
+	output, err := format.Source(g.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("format: %w", err)
+	}
+
+	return string(output), nil
+}
+
+// sortApplicationCommands sorts application commands by key.
+func sortApplicationCommands(commands []*disgo.ApplicationCommand) []*disgo.ApplicationCommand {
+	return slices.SortedFunc(slices.Values(commands), func(a, b *disgo.ApplicationCommand) int {
+		return compareCommandKeys(commandKey(a.Type, a.Name), commandKey(b.Type, b.Name))
+	})
+}
+
+// trimGlobalApplicationCommand removes the values of a global application command
+// which equal the default values Discord fills in.
+func trimGlobalApplicationCommand(command disgo.CreateGlobalApplicationCommand) disgo.CreateGlobalApplicationCommand {
+	command.NameLocalizations = normalizeLocalizations(command.NameLocalizations)
+	command.Description = trimPointer(command.Description)
+	command.DescriptionLocalizations = normalizeLocalizations(command.DescriptionLocalizations)
+	command.Options = trimOptions(command.Options)
+	command.NSFW = trimPointer(command.NSFW)
+
+	if command.DefaultMemberPermissions != nil && *command.DefaultMemberPermissions == nil {
+		command.DefaultMemberPermissions = nil
+	}
+
+	if slices.Equal(command.IntegrationTypes, []disgo.Flag{disgo.FlagApplicationIntegrationTypeGUILD_INSTALL}) {
+		command.IntegrationTypes = nil
+	}
+
+	return command
+}
+
+// trimGuildApplicationCommand removes the values of a guild application command
+// which equal the default values Discord fills in.
+func trimGuildApplicationCommand(command disgo.CreateGuildApplicationCommand) disgo.CreateGuildApplicationCommand {
+	command.NameLocalizations = normalizeLocalizations(command.NameLocalizations)
+	command.Description = trimPointer(command.Description)
+	command.DescriptionLocalizations = normalizeLocalizations(command.DescriptionLocalizations)
+	command.Options = trimOptions(command.Options)
+	command.NSFW = trimPointer(command.NSFW)
+
+	if command.DefaultMemberPermissions != nil && *command.DefaultMemberPermissions == nil {
+		command.DefaultMemberPermissions = nil
+	}
+
+	return command
+}
+
+// trimOptions returns a copy of application command options
+// without the values which equal the default values Discord fills in.
+func trimOptions(options []*disgo.ApplicationCommandOption) []*disgo.ApplicationCommandOption {
+	options = normalizeOptions(options)
+
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+
+		option.Required = trimPointer(option.Required)
+		option.Autocomplete = trimPointer(option.Autocomplete)
+		option.Options = trimOptions(option.Options)
+	}
+
+	return options
+}
+
+// trimPointer returns nil when a pointer points to a zero value.
+func trimPointer[T comparable](p *T) *T {
+	var zero T
+	if p == nil || *p == zero {
+		return nil
+	}
+
+	return p
+}
+
+// applicationCommandTypeConstants represents a map of application command types to the names of their constants.
+var applicationCommandTypeConstants = map[disgo.Flag]string{
+	disgo.FlagApplicationCommandTypeCHAT_INPUT:          "FlagApplicationCommandTypeCHAT_INPUT",
+	disgo.FlagApplicationCommandTypeUSER:                "FlagApplicationCommandTypeUSER",
+	disgo.FlagApplicationCommandTypeMESSAGE:             "FlagApplicationCommandTypeMESSAGE",
+	disgo.FlagApplicationCommandTypePRIMARY_ENTRY_POINT: "FlagApplicationCommandTypePRIMARY_ENTRY_POINT",
+}
+
+// flagConstants represents a map of struct fields to the names of the constants of their flags.
+var flagConstants = map[string]map[disgo.Flag]string{
+	"CreateGlobalApplicationCommand.Type": applicationCommandTypeConstants,
+	"CreateGuildApplicationCommand.Type":  applicationCommandTypeConstants,
+	"CreateGlobalApplicationCommand.IntegrationTypes": {
+		disgo.FlagApplicationIntegrationTypeGUILD_INSTALL: "FlagApplicationIntegrationTypeGUILD_INSTALL",
+		disgo.FlagApplicationIntegrationTypeUSER_INSTALL:  "FlagApplicationIntegrationTypeUSER_INSTALL",
+	},
+	"CreateGlobalApplicationCommand.Contexts": {
+		disgo.FlagInteractionContextTypeGUILD:           "FlagInteractionContextTypeGUILD",
+		disgo.FlagInteractionContextTypeBOT_DM:          "FlagInteractionContextTypeBOT_DM",
+		disgo.FlagInteractionContextTypePRIVATE_CHANNEL: "FlagInteractionContextTypePRIVATE_CHANNEL",
+	},
+	"ApplicationCommandOption.Type": {
+		disgo.FlagApplicationCommandOptionTypeSUB_COMMAND:       "FlagApplicationCommandOptionTypeSUB_COMMAND",
+		disgo.FlagApplicationCommandOptionTypeSUB_COMMAND_GROUP: "FlagApplicationCommandOptionTypeSUB_COMMAND_GROUP",
+		disgo.FlagApplicationCommandOptionTypeSTRING:            "FlagApplicationCommandOptionTypeSTRING",
+		disgo.FlagApplicationCommandOptionTypeINTEGER:           "FlagApplicationCommandOptionTypeINTEGER",
+		disgo.FlagApplicationCommandOptionTypeBOOLEAN:           "FlagApplicationCommandOptionTypeBOOLEAN",
+		disgo.FlagApplicationCommandOptionTypeUSER:              "FlagApplicationCommandOptionTypeUSER",
+		disgo.FlagApplicationCommandOptionTypeCHANNEL:           "FlagApplicationCommandOptionTypeCHANNEL",
+		disgo.FlagApplicationCommandOptionTypeROLE:              "FlagApplicationCommandOptionTypeROLE",
+		disgo.FlagApplicationCommandOptionTypeMENTIONABLE:       "FlagApplicationCommandOptionTypeMENTIONABLE",
+		disgo.FlagApplicationCommandOptionTypeNUMBER:            "FlagApplicationCommandOptionTypeNUMBER",
+		disgo.FlagApplicationCommandOptionTypeATTACHMENT:        "FlagApplicationCommandOptionTypeATTACHMENT",
+	},
+	"ApplicationCommandOption.ChannelTypes": {
+		disgo.FlagChannelTypeGUILD_TEXT:          "FlagChannelTypeGUILD_TEXT",
+		disgo.FlagChannelTypeDM:                  "FlagChannelTypeDM",
+		disgo.FlagChannelTypeGUILD_VOICE:         "FlagChannelTypeGUILD_VOICE",
+		disgo.FlagChannelTypeGROUP_DM:            "FlagChannelTypeGROUP_DM",
+		disgo.FlagChannelTypeGUILD_CATEGORY:      "FlagChannelTypeGUILD_CATEGORY",
+		disgo.FlagChannelTypeGUILD_ANNOUNCEMENT:  "FlagChannelTypeGUILD_ANNOUNCEMENT",
+		disgo.FlagChannelTypeANNOUNCEMENT_THREAD: "FlagChannelTypeANNOUNCEMENT_THREAD",
+		disgo.FlagChannelTypePUBLIC_THREAD:       "FlagChannelTypePUBLIC_THREAD",
+		disgo.FlagChannelTypePRIVATE_THREAD:      "FlagChannelTypePRIVATE_THREAD",
+		disgo.FlagChannelTypeGUILD_STAGE_VOICE:   "FlagChannelTypeGUILD_STAGE_VOICE",
+		disgo.FlagChannelTypeGUILD_DIRECTORY:     "FlagChannelTypeGUILD_DIRECTORY",
+		disgo.FlagChannelTypeGUILD_FORUM:         "FlagChannelTypeGUILD_FORUM",
+		disgo.FlagChannelTypeGUILD_MEDIA:         "FlagChannelTypeGUILD_MEDIA",
+	},
+}
+
+// generator represents a Go source code generator.
+type generator struct {
+	bytes.Buffer
+}
+
+// printf writes formatted source code.
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(g, format, args...)
+}
+
+// value writes the literal of a value.
+//
+// field represents the struct field (e.g., ApplicationCommandOption.Type) the value is assigned to.
+func (g *generator) value(v reflect.Value, field string) {
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Pointer:
+		switch elem := v.Elem(); {
+		case elem.Kind() == reflect.Pointer && elem.IsNil():
+			g.printf("disgo.Pointer2(%s, true)", literal(reflect.Zero(elem.Type().Elem()), field))
+		case elem.Kind() == reflect.Pointer:
+			g.printf("disgo.Pointer2(")
+			g.value(elem.Elem(), field)
+			g.printf(")")
+		case elem.Kind() == reflect.Map:
+			g.printf("&")
+			g.value(elem, field)
+		case elem.Kind() == reflect.Struct:
+			g.body(elem)
+		default:
+			g.printf("disgo.Pointer(")
+			g.value(elem, field)
+			g.printf(")")
+		}
+
+	case reflect.Struct:
+		g.body(v)
+
+	case reflect.Slice:
+		g.printf("%s{\n", typeString(v.Type()))
+
+		for i := range v.Len() {
+			g.value(v.Index(i), field)
+			g.printf(",\n")
+		}
+
+		g.printf("}")
+
+	case reflect.Map:
+		g.printf("%s{\n", typeString(v.Type()))
+
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(a.String(), b.String())
+		})
+
+		for _, key := range keys {
+			g.printf("%s: ", literal(key, field))
+			g.value(v.MapIndex(key), field)
+			g.printf(",\n")
+		}
+
+		g.printf("}")
+
+	default:
+		g.printf("%s", literal(v, field))
+	}
+}
+
+// leadingFields represents the fields which are written first in a struct literal.
+var leadingFields = []string{"GuildID", "Name", "Description", "Type"}
+
+// body writes the body of a struct literal which omits zero fields.
+func (g *generator) body(v reflect.Value) {
+	g.printf("{\n")
+
+	fields := make([]int, v.NumField())
+	for i := range fields {
+		fields[i] = i
+	}
+
+	slices.SortStableFunc(fields, func(a, b int) int {
+		rank := func(i int) int {
+			if index := slices.Index(leadingFields, v.Type().Field(i).Name); index != -1 {
+				return index
+			}
+
+			return len(leadingFields)
+		}
+
+		return rank(a) - rank(b)
+	})
+
+	for _, i := range fields {
+		field := v.Type().Field(i)
+		if !field.IsExported() || v.Field(i).IsZero() {
+			continue
+		}
+
+		g.printf("%s: ", field.Name)
+		g.value(v.Field(i), v.Type().Name()+"."+field.Name)
+		g.printf(",\n")
+	}
+
+	g.printf("}")
+}
+
+// literal returns the literal of a basic value.
+func literal(v reflect.Value, field string) string {
+	if v.Type() == reflect.TypeOf(disgo.Flag(0)) {
+		if name, ok := flagConstants[field][disgo.Flag(v.Uint())]; ok {
+			return "disgo." + name
+		}
+
+		return fmt.Sprintf("disgo.Flag(%d)", v.Uint())
+	}
+
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.String:
+		return strconv.Quote(v.String())
+
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+
+	case reflect.Float32, reflect.Float64:
+		// a float literal must contain a decimal point to remain a float when its type is inferred.
+		f := strconv.FormatFloat(v.Float(), 'g', -1, 64)
+		if !strings.ContainsAny(f, ".eEnN") {
+			f += ".0"
+		}
+
+		return f
+	}
+
+	return fmt.Sprintf("%#v", v.Interface())
+}
+
+// typeString returns the Go syntax of a type.
+func typeString(t reflect.Type) string {
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.Pointer:
+		return "*" + typeString(t.Elem())
+	case reflect.Slice:
+		if t.Name() == "" {
+			return "[]" + typeString(t.Elem())
+		}
+	case reflect.Map:
+		return "map[" + typeString(t.Key()) + "]" + typeString(t.Elem())
+	}
+
+	if t.PkgPath() != "" {
+		return "disgo." + t.Name()
+	}
+
+	return t.Name()
+}
@@ -0,0 +1,271 @@
+package disgoform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/switchupcb/disgo"
+)
+
+// Config represents the configuration of a Syncer.
+type Config struct {
+	// Client represents the bot which application commands are synchronized for.
+	Client *disgo.Client
+
+	// GlobalApplicationCommands represents the global application commands of the bot (max: 111).
+	GlobalApplicationCommands []disgo.CreateGlobalApplicationCommand
+
+	// GuildApplicationCommands represents the guild application commands of the bot.
+	GuildApplicationCommands []disgo.CreateGuildApplicationCommand
+
+	// PatchedApplicationCommands represents the guild application commands of the bot
+	// which are created in multiple guilds with per-guild modifications.
+	PatchedApplicationCommands []PatchedApplicationCommand
+
+	// GuildApplicationCommandTemplates represents the guild application commands of the bot
+	// which are created in every matching guild.
+	GuildApplicationCommandTemplates []GuildApplicationCommandTemplate
+
+	// ApplicationCommandPermissions represents the permission overwrites of the bot's application commands in each guild.
+	ApplicationCommandPermissions []CommandPermissions
+
+	// EntryPointHandler represents the entry point handler of the PRIMARY_ENTRY_POINT global application command
+	// (default: the handler of the current application command).
+	EntryPointHandler *disgo.Flag
+
+	// PermissionsAuthentication represents the Bearer token used to edit application command permissions.
+	PermissionsAuthentication *disgo.Authentication
+
+	// Equal returns whether two application commands are equal (default: Equivalent).
+	Equal func(x, y any) bool
+
+	// Logger represents the logger used to log synchronization events
+	// (default: disgo.Logger using a ZerologHandler).
+	Logger *slog.Logger
+
+	// DryRun represents whether a synchronization only reads the current application command state.
+	DryRun bool
+
+	// Strategy represents the strategy used to apply a plan.
+	Strategy Strategy
+
+	// GuildDiscovery represents the method used to discover the guilds which are synchronized.
+	GuildDiscovery GuildDiscovery
+
+	// GuildPolicy represents the policy used to determine the discovered guilds which are managed.
+	GuildPolicy GuildPolicy
+
+	// ManagedGuildIDs represents the IDs of the guilds which are managed (GuildPolicyAllowlist).
+	ManagedGuildIDs []string
+
+	// WarnUnreachableGuilds represents whether guild application commands defined for guilds the bot is not in
+	// are logged instead of returned as an error.
+	WarnUnreachableGuilds bool
+
+	// OwnedPrefix represents the name prefix of the current application commands which are owned.
+	OwnedPrefix string
+
+	// OwnedNames represents the names of the current application commands which are owned.
+	OwnedNames []string
+
+	// StateFile represents the name of the file which records the application commands that are owned.
+	StateFile string
+
+	// Adopt represents whether current application commands which are defined,
+	// but not recorded in the state file, are edited and recorded (AdoptCommands).
+	Adopt bool
+
+	// PreventDestroy represents the application commands which are never deleted (or recreated).
+	PreventDestroy []ProtectedCommand
+
+	// MaxDeletions represents the maximum amount of application commands a plan can delete (default: no maximum).
+	MaxDeletions *int
+
+	// ContinueOnError represents whether a synchronization attempts every operation (and guild)
+	// when an operation fails.
+	ContinueOnError bool
+
+	// RequiredLocales represents the locales every localization map must provide (Validate).
+	RequiredLocales []string
+}
+
+// Syncer represents a synchronizer of a bot's application commands.
+//
+// A Syncer does not use the package-level GlobalApplicationCommands, GuildApplicationCommands, and Equal variables,
+// so multiple bots can be synchronized from one process.
+type Syncer struct {
+	Config Config
+}
+
+// NewSyncer returns a Syncer which synchronizes application commands using a configuration.
+func NewSyncer(config Config) *Syncer {
+	return &Syncer{Config: config}
+}
+
+// defaultSyncer returns the Syncer used by the package-level functions,
+// which is configured using the package-level variables.
+func defaultSyncer(bot *disgo.Client) *Syncer {
+	return NewSyncer(Config{ //nolint:exhaustruct
+		Client:                           bot,
+		GlobalApplicationCommands:        GlobalApplicationCommands,
+		GuildApplicationCommands:         GuildApplicationCommands,
+		PatchedApplicationCommands:       PatchedApplicationCommands,
+		GuildApplicationCommandTemplates: GuildApplicationCommandTemplates,
+		ApplicationCommandPermissions:    ApplicationCommandPermissions,
+		PreventDestroy:                   PreventDestroy,
+		Equal:                            Equal,
+		Logger:                           nil,
+	})
+}
+
+// config returns a copy of the Syncer's configuration modified by options.
+func (s *Syncer) config(opts []Option) (*Config, error) {
+	c := s.Config
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	if c.Client == nil {
+		return nil, errors.New("cannot synchronize application commands using nil client")
+	}
+
+	if c.Equal == nil {
+		c.Equal = Equivalent
+	}
+
+	if c.Logger == nil {
+		c.Logger = defaultLogger()
+	}
+
+	if err := expandPatchedApplicationCommands(&c); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// Sync synchronizes Global and Guild application commands.
+func (s *Syncer) Sync(opts ...Option) (*Result, error) {
+	return s.SyncContext(context.Background(), opts...)
+}
+
+// SyncContext synchronizes Global and Guild application commands using a context.
+//
+// SyncContext stops sending requests to Discord when the context is canceled
+// after the request in progress (including its rate limit wait),
+// and returns a Result containing the operations executed prior to the cancellation.
+//
+// The context is checked between requests, since disgo requests do not use a context:
+// a request in progress is completed, then no further request is sent.
+func (s *Syncer) SyncContext(ctx context.Context, opts ...Option) (*Result, error) {
+	c, err := s.config(opts)
+	if err != nil {
+		return nil, fmt.Errorf("Sync: %w", err)
+	}
+
+	plan, err := planApplicationCommands(ctx, c, false)
+	if plan == nil || (err != nil && ctx.Err() != nil) {
+		return newResult(nil, nil, c.DryRun), fmt.Errorf("Sync: %w", err)
+	}
+
+	// apply the plans of the scopes which are planned (ContinueOnError),
+	// unless they delete protected application commands (or too many).
+	result, applyErr := syncPlan(ctx, c, plan, "Sync")
+
+	var deletionErr *DeletionError
+	if errors.As(applyErr, &deletionErr) {
+		return result, applyErr
+	}
+
+	err = mergeErrors(err, errors.Unwrap(applyErr))
+	if err != nil && (!c.ContinueOnError || ctx.Err() != nil) {
+		return result, fmt.Errorf("Sync: %w", err)
+	}
+
+	// synchronize permissions once the IDs of created application commands are known.
+	permissions, permissionsErr := syncPermissions(ctx, c)
+	result.Permissions = permissions
+
+	if err != nil || permissionsErr != nil {
+		return result, fmt.Errorf("Sync: %w", mergeErrors(err, permissionsErr))
+	}
+
+	return result, nil
+}
+
+// SyncGlobalApplicationCommands synchronizes Global application commands.
+func (s *Syncer) SyncGlobalApplicationCommands(opts ...Option) (*Result, error) {
+	return s.SyncGlobalApplicationCommandsContext(context.Background(), opts...)
+}
+
+// SyncGlobalApplicationCommandsContext synchronizes Global application commands using a context.
+//
+// SyncGlobalApplicationCommandsContext stops sending requests to Discord when the context is canceled
+// after the request in progress (including its rate limit wait),
+// and returns a Result containing the operations executed prior to the cancellation.
+func (s *Syncer) SyncGlobalApplicationCommandsContext(ctx context.Context, opts ...Option) (*Result, error) {
+	c, err := s.config(opts)
+	if err != nil {
+		return nil, fmt.Errorf("SyncGlobalApplicationCommands: %w", err)
+	}
+
+	plan, err := planGlobalApplicationCommands(ctx, c)
+	if err != nil {
+		return newResult(nil, nil, c.DryRun), fmt.Errorf("SyncGlobalApplicationCommands: %w", err)
+	}
+
+	return syncPlan(ctx, c, plan, "SyncGlobalApplicationCommands")
+}
+
+// SyncGuildApplicationCommands synchronizes Guild application commands.
+//
+// The guilds which are synchronized are discovered using the WithGuildDiscovery option,
+// then filtered using the WithGuildPolicy option.
+func (s *Syncer) SyncGuildApplicationCommands(opts ...Option) (*Result, error) {
+	return s.SyncGuildApplicationCommandsContext(context.Background(), opts...)
+}
+
+// SyncGuildApplicationCommandsContext synchronizes Guild application commands using a context.
+//
+// SyncGuildApplicationCommandsContext stops sending requests to Discord when the context is canceled
+// after the request in progress (including its rate limit wait), disconnects any Gateway session used to discover guilds,
+// and returns a Result containing the operations executed prior to the cancellation.
+func (s *Syncer) SyncGuildApplicationCommandsContext(ctx context.Context, opts ...Option) (*Result, error) {
+	c, err := s.config(opts)
+	if err != nil {
+		return nil, fmt.Errorf("SyncGuildApplicationCommands: %w", err)
+	}
+
+	plan, err := planGuildApplicationCommands(ctx, c, false)
+	if plan == nil {
+		return newResult(nil, nil, c.DryRun), fmt.Errorf("SyncGuildApplicationCommands: %w", err)
+	}
+
+	// apply the plans of the guilds which are planned (ContinueOnError).
+	result, applyErr := syncPlan(ctx, c, plan, "SyncGuildApplicationCommands")
+	if err != nil {
+		return result, fmt.Errorf("SyncGuildApplicationCommands: %w", mergeErrors(err, errors.Unwrap(applyErr)))
+	}
+
+	return result, applyErr
+}
+
+// syncPlan executes the operations of a plan computed by a synchronization.
+func syncPlan(ctx context.Context, c *Config, plan *ChangePlan, caller string) (*Result, error) {
+	if err := checkDeletions(c, plan); err != nil {
+		return newResult(nil, nil, c.DryRun), fmt.Errorf("%s: %w", caller, err)
+	}
+
+	if c.DryRun {
+		return newResult(plan, planned(plan), true), nil
+	}
+
+	applied, err := apply(ctx, c, plan)
+	if err != nil {
+		return newResult(plan, applied, false), fmt.Errorf("%s: %w", caller, err)
+	}
+
+	return newResult(plan, applied, false), nil
+}
@@ -0,0 +1,141 @@
+package disgoform
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/switchupcb/disgo"
+)
+
+// GuildSelector returns whether a guild application command template is created in a guild.
+//
+// A GuildSelector is called for every discovered guild during a synchronization.
+type GuildSelector func(ctx context.Context, guildID string) (bool, error)
+
+// GuildApplicationCommandTemplate represents a guild application command which is created in every matching guild.
+//
+// A guild matches a template when it's referenced by GuildIDs or selected by the Selector.
+// A template without GuildIDs and a Selector matches every discovered guild.
+//
+// The application command of a template is deleted from a discovered guild which stops matching the template.
+type GuildApplicationCommandTemplate struct {
+	// Command represents the application command which is created in each matching guild (the GuildID is ignored).
+	Command disgo.CreateGuildApplicationCommand
+
+	// GuildIDs represents the guilds which the application command is always created in.
+	GuildIDs []string
+
+	// Selector represents the function used to select the discovered guilds which the application command is created in.
+	Selector GuildSelector
+}
+
+// dynamic returns whether the guilds of a template are determined from the discovered guilds.
+func (t *GuildApplicationCommandTemplate) dynamic() bool {
+	return len(t.GuildIDs) == 0 || t.Selector != nil
+}
+
+// defineGuildApplicationCommand adds a defined guild application command to a map of GuildIDs to a map of keys to guild application commands.
+func defineGuildApplicationCommand(definedCommandGuildIDMap map[string]map[CommandKey]disgo.CreateGuildApplicationCommand, definedCommand disgo.CreateGuildApplicationCommand) error {
+	if definedCommand.GuildID == "" {
+		return fmt.Errorf("cannot define guild application command with name %q using empty guild id", definedCommand.Name)
+	}
+
+	if _, ok := definedCommandGuildIDMap[definedCommand.GuildID]; !ok {
+		definedCommandGuildIDMap[definedCommand.GuildID] = make(map[CommandKey]disgo.CreateGuildApplicationCommand)
+	}
+
+	if definedCommand.Name == "" {
+		return fmt.Errorf("cannot define guild application command for guild %q using empty name", definedCommand.GuildID)
+	}
+
+	key := commandKey(definedCommand.Type, definedCommand.Name)
+	if _, ok := definedCommandGuildIDMap[definedCommand.GuildID][key]; ok {
+		return fmt.Errorf("more than one %s command exists with name %q for guild %q", commandTypeName(key.Type), key.Name, definedCommand.GuildID)
+	}
+
+	definedCommandGuildIDMap[definedCommand.GuildID][key] = definedCommand
+
+	return nil
+}
+
+// defineStaticTemplates adds the application commands of the templates in each of their GuildIDs
+// to a map of GuildIDs to a map of keys to guild application commands.
+func defineStaticTemplates(c *Config, definedCommandGuildIDMap map[string]map[CommandKey]disgo.CreateGuildApplicationCommand) error {
+	for _, template := range c.GuildApplicationCommandTemplates {
+		for _, guildID := range template.GuildIDs {
+			command := template.Command
+			command.GuildID = guildID
+
+			if err := defineGuildApplicationCommand(definedCommandGuildIDMap, command); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// defineSelectedTemplates adds the application commands of the templates in each matching discovered guild
+// to a map of GuildIDs to a map of keys to guild application commands.
+func defineSelectedTemplates(ctx context.Context, c *Config, guildIDs []string, definedCommandGuildIDMap map[string]map[CommandKey]disgo.CreateGuildApplicationCommand) error {
+	for _, template := range c.GuildApplicationCommandTemplates {
+		if !template.dynamic() {
+			continue
+		}
+
+		count := 0
+
+		for _, guildID := range guildIDs {
+			// the application command is already defined in the guilds of the static guild list.
+			if slices.Contains(template.GuildIDs, guildID) {
+				count++
+
+				continue
+			}
+
+			if template.Selector != nil {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				selected, err := template.Selector(ctx, guildID)
+				if err != nil {
+					return fmt.Errorf("cannot select guild %q for guild application command template %q: %w", guildID, template.Command.Name, err)
+				}
+
+				if !selected {
+					continue
+				}
+			}
+
+			command := template.Command
+			command.GuildID = guildID
+
+			if err := defineGuildApplicationCommand(definedCommandGuildIDMap, command); err != nil {
+				return err
+			}
+
+			count++
+		}
+
+		c.Logger.Debug("selected guilds for guild application command template",
+			slog.String(logKeyScope, string(ScopeGuild)),
+			slog.String(logKeyCommand, commandKey(template.Command.Type, template.Command.Name).String()),
+			slog.Int(logKeyCount, count),
+		)
+	}
+
+	return nil
+}
+
+// templateCommandKeys returns the keys of the application commands of the templates.
+func templateCommandKeys(templates []GuildApplicationCommandTemplate) map[CommandKey]bool {
+	keys := make(map[CommandKey]bool, len(templates))
+	for _, template := range templates {
+		keys[commandKey(template.Command.Type, template.Command.Name)] = true
+	}
+
+	return keys
+}
@@ -1,7 +1,11 @@
 package tests
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/rs/zerolog"
@@ -9,9 +13,31 @@ import (
 	"github.com/switchupcb/disgoform"
 )
 
+// restoreDefinitions restores the package-level application command definitions when a test completes.
+func restoreDefinitions(t *testing.T) {
+	t.Helper()
+
+	global := disgoform.GlobalApplicationCommands
+	guild := disgoform.GuildApplicationCommands
+	patched := disgoform.PatchedApplicationCommands
+	templates := disgoform.GuildApplicationCommandTemplates
+	permissions := disgoform.ApplicationCommandPermissions
+	protected := disgoform.PreventDestroy
+
+	t.Cleanup(func() {
+		disgoform.GlobalApplicationCommands = global
+		disgoform.GuildApplicationCommands = guild
+		disgoform.PatchedApplicationCommands = patched
+		disgoform.GuildApplicationCommandTemplates = templates
+		disgoform.ApplicationCommandPermissions = permissions
+		disgoform.PreventDestroy = protected
+	})
+}
+
 // TestSyncGlobalApplicationCommands tests SyncGlobalApplicationCommands() functionality.
 func TestSyncGlobalApplicationCommands(t *testing.T) {
 	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	restoreDefinitions(t)
 
 	bot := &disgo.Client{
 		ApplicationID:  os.Getenv("APPID"),
@@ -20,7 +46,7 @@ func TestSyncGlobalApplicationCommands(t *testing.T) {
 	}
 
 	// global defined command reset
-	if err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
 		t.Fatalf("reset: %v", err)
 	}
 
@@ -36,7 +62,7 @@ func TestSyncGlobalApplicationCommands(t *testing.T) {
 
 	// global defined command empty name
 	disgoform.GlobalApplicationCommands = append(disgoform.GlobalApplicationCommands, disgo.CreateGlobalApplicationCommand{})
-	if err := disgoform.SyncGlobalApplicationCommands(bot); err == nil {
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot); err == nil {
 		t.Fatalf("expected error while syncing application command with empty name")
 	}
 
@@ -50,7 +76,7 @@ func TestSyncGlobalApplicationCommands(t *testing.T) {
 		},
 	}
 
-	if err := disgoform.SyncGlobalApplicationCommands(bot); err == nil {
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot); err == nil {
 		t.Fatalf("expected error while syncing application command with duplicate name")
 	}
 
@@ -63,7 +89,7 @@ func TestSyncGlobalApplicationCommands(t *testing.T) {
 		},
 	}
 
-	if err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
 		t.Fatalf("add command: %v", err)
 	}
 
@@ -114,7 +140,7 @@ func TestSyncGlobalApplicationCommands(t *testing.T) {
 		},
 	}
 
-	if err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
 		t.Fatalf("add command and update command: %v", err)
 	}
 
@@ -129,7 +155,7 @@ func TestSyncGlobalApplicationCommands(t *testing.T) {
 
 	// global defined command delete all
 	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{}
-	if err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
 		t.Fatalf("delete all commands: %v", err)
 	}
 
@@ -146,6 +172,7 @@ func TestSyncGlobalApplicationCommands(t *testing.T) {
 // TestSyncGuildApplicationCommands tests SyncGuildApplicationCommands() functionality.
 func TestSyncGuildApplicationCommands(t *testing.T) {
 	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	restoreDefinitions(t)
 
 	bot := &disgo.Client{
 		ApplicationID:  os.Getenv("APPID"),
@@ -156,19 +183,19 @@ func TestSyncGuildApplicationCommands(t *testing.T) {
 	guildid := os.Getenv("GUILDID")
 
 	// guild defined command reset
-	if err := disgoform.SyncGuildApplicationCommands(bot); err != nil {
+	if _, err := disgoform.SyncGuildApplicationCommands(bot); err != nil {
 		t.Fatalf("reset: %v", err)
 	}
 
 	// guild defined command empty guild id
 	disgoform.GuildApplicationCommands = append(disgoform.GuildApplicationCommands, disgo.CreateGuildApplicationCommand{})
-	if err := disgoform.SyncGuildApplicationCommands(bot); err == nil {
+	if _, err := disgoform.SyncGuildApplicationCommands(bot); err == nil {
 		t.Fatalf("expected error while syncing guild application command with empty guild id")
 	}
 
 	// guild defined command guild id with empty name
 	disgoform.GuildApplicationCommands[0].GuildID = "0"
-	if err := disgoform.SyncGuildApplicationCommands(bot); err == nil {
+	if _, err := disgoform.SyncGuildApplicationCommands(bot); err == nil {
 		t.Fatalf("expected error while syncing guild application command with empty name")
 	}
 
@@ -194,7 +221,7 @@ func TestSyncGuildApplicationCommands(t *testing.T) {
 		},
 	}
 
-	if err := disgoform.SyncGuildApplicationCommands(bot); err != nil {
+	if _, err := disgoform.SyncGuildApplicationCommands(bot); err != nil {
 		t.Fatalf("add command: %v", err)
 	}
 
@@ -227,7 +254,7 @@ func TestSyncGuildApplicationCommands(t *testing.T) {
 		},
 	}
 
-	if err := disgoform.SyncGuildApplicationCommands(bot); err != nil {
+	if _, err := disgoform.SyncGuildApplicationCommands(bot); err != nil {
 		t.Fatalf("add command and update command: %v", err)
 	}
 
@@ -242,7 +269,7 @@ func TestSyncGuildApplicationCommands(t *testing.T) {
 
 	// guild defined command delete all
 	disgoform.GuildApplicationCommands = []disgo.CreateGuildApplicationCommand{}
-	if err := disgoform.SyncGuildApplicationCommands(bot); err != nil {
+	if _, err := disgoform.SyncGuildApplicationCommands(bot); err != nil {
 		t.Fatalf("delete all commands: %v", err)
 	}
 
@@ -255,3 +282,895 @@ func TestSyncGuildApplicationCommands(t *testing.T) {
 		t.Fatal("delete all commands: amount of guild application commands is not 0", err)
 	}
 }
+
+// TestPlanApply tests PlanGlobalApplicationCommands() and Apply() functionality.
+func TestPlanApply(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	restoreDefinitions(t)
+
+	bot := &disgo.Client{
+		ApplicationID:  os.Getenv("APPID"),
+		Authentication: disgo.BotToken(os.Getenv("TOKEN")),
+		Config:         disgo.DefaultConfig(),
+	}
+
+	// global defined command reset
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{}
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+
+	// plan global defined command from no state
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{
+		{
+			Name:        "main",
+			Description: disgo.Pointer("A basic command."),
+			Type:        disgo.Pointer(disgo.FlagApplicationCommandTypeCHAT_INPUT),
+		},
+	}
+
+	plan, err := disgoform.PlanGlobalApplicationCommands(bot)
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+
+	if len(plan.Operations) != 1 || plan.Operations[0].Action != disgoform.ActionCreate {
+		t.Fatalf("plan: expected 1 create operation, got:\n%v", plan)
+	}
+
+	// a plan does not modify the current state.
+	getGlobalApplicatonCommands := &disgo.GetGlobalApplicationCommands{}
+	currentCommands, err := getGlobalApplicatonCommands.Send(bot)
+	if err != nil {
+		t.Fatalf("plan: confirmation: %v", err)
+	}
+
+	if len(currentCommands) != 0 {
+		t.Fatal("plan: confirmation: amount of global application commands is not 0")
+	}
+
+	// apply the plan
+	if _, err := disgoform.Apply(bot, plan); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+
+	currentCommands, err = getGlobalApplicatonCommands.Send(bot)
+	if err != nil {
+		t.Fatalf("apply: confirmation: %v", err)
+	}
+
+	if len(currentCommands) != 1 {
+		t.Fatal("apply: confirmation: amount of global application commands is not 1")
+	}
+
+	// apply the stale plan
+	if _, err := disgoform.Apply(bot, plan); !errors.Is(err, disgoform.ErrStalePlan) {
+		t.Fatalf("apply stale plan: expected ErrStalePlan, got %v", err)
+	}
+
+	// global defined command delete all
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{}
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
+		t.Fatalf("delete all commands: %v", err)
+	}
+}
+
+// TestDryRun tests the DryRun() option.
+func TestDryRun(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	restoreDefinitions(t)
+
+	bot := &disgo.Client{
+		ApplicationID:  os.Getenv("APPID"),
+		Authentication: disgo.BotToken(os.Getenv("TOKEN")),
+		Config:         disgo.DefaultConfig(),
+	}
+
+	// global defined command reset
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{}
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+
+	// dry run global defined command from no state
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{
+		{
+			Name:        "main",
+			Description: disgo.Pointer("A basic command."),
+			Type:        disgo.Pointer(disgo.FlagApplicationCommandTypeCHAT_INPUT),
+		},
+	}
+
+	result, err := disgoform.SyncGlobalApplicationCommands(bot, disgoform.DryRun())
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+
+	if !result.DryRun || len(result.Operations) != 1 || result.Operations[0].Action != disgoform.ActionCreate {
+		t.Fatalf("dry run: expected 1 create operation, got:\n%v", result)
+	}
+
+	getGlobalApplicatonCommands := &disgo.GetGlobalApplicationCommands{}
+	currentCommands, err := getGlobalApplicatonCommands.Send(bot)
+	if err != nil {
+		t.Fatalf("dry run: confirmation: %v", err)
+	}
+
+	if len(currentCommands) != 0 {
+		t.Fatal("dry run: confirmation: amount of global application commands is not 0")
+	}
+
+	// global defined command reset
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{}
+}
+
+// TestSyncCommandTypes tests synchronization of application commands which share a name.
+func TestSyncCommandTypes(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	restoreDefinitions(t)
+
+	bot := &disgo.Client{
+		ApplicationID:  os.Getenv("APPID"),
+		Authentication: disgo.BotToken(os.Getenv("TOKEN")),
+		Config:         disgo.DefaultConfig(),
+	}
+
+	// global defined commands with the same name and different types
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{
+		{
+			Name:        "report",
+			Description: disgo.Pointer("Report a user."),
+			Type:        disgo.Pointer(disgo.FlagApplicationCommandTypeCHAT_INPUT),
+		},
+		{
+			Name: "report",
+			Type: disgo.Pointer(disgo.FlagApplicationCommandTypeMESSAGE),
+		},
+	}
+
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
+		t.Fatalf("add commands: %v", err)
+	}
+
+	getGlobalApplicatonCommands := &disgo.GetGlobalApplicationCommands{}
+	currentCommands, err := getGlobalApplicatonCommands.Send(bot)
+	if err != nil {
+		t.Fatalf("add commands: confirmation: %v", err)
+	}
+
+	if len(currentCommands) != 2 {
+		t.Fatal("add commands: confirmation: amount of global application commands is not 2")
+	}
+
+	// global defined command delete one type
+	disgoform.GlobalApplicationCommands = disgoform.GlobalApplicationCommands[:1]
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
+		t.Fatalf("delete command: %v", err)
+	}
+
+	currentCommands, err = getGlobalApplicatonCommands.Send(bot)
+	if err != nil {
+		t.Fatalf("delete command: confirmation: %v", err)
+	}
+
+	if len(currentCommands) != 1 || *currentCommands[0].Type != disgo.FlagApplicationCommandTypeCHAT_INPUT {
+		t.Fatal("delete command: confirmation: expected 1 CHAT_INPUT global application command")
+	}
+
+	// global defined command type change
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{
+		{
+			Name: "report",
+			Type: disgo.Pointer(disgo.FlagApplicationCommandTypeMESSAGE),
+		},
+	}
+
+	result, err := disgoform.SyncGlobalApplicationCommands(bot)
+	if err != nil {
+		t.Fatalf("recreate command: %v", err)
+	}
+
+	if len(result.Operations) != 1 || result.Operations[0].Action != disgoform.ActionRecreate {
+		t.Fatalf("recreate command: expected 1 recreate operation, got:\n%v", result)
+	}
+
+	// global defined command delete all
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{}
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
+		t.Fatalf("delete all commands: %v", err)
+	}
+}
+
+// TestBulkStrategy tests the StrategyBulk strategy.
+func TestBulkStrategy(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	restoreDefinitions(t)
+
+	bot := &disgo.Client{
+		ApplicationID:  os.Getenv("APPID"),
+		Authentication: disgo.BotToken(os.Getenv("TOKEN")),
+		Config:         disgo.DefaultConfig(),
+	}
+
+	bulk := disgoform.WithStrategy(disgoform.StrategyBulk)
+
+	// global defined commands from no state
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{
+		{
+			Name:        "main",
+			Description: disgo.Pointer("A basic command."),
+		},
+		{
+			Name:        "other",
+			Description: disgo.Pointer("Another basic command."),
+		},
+	}
+
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot, bulk); err != nil {
+		t.Fatalf("add commands: %v", err)
+	}
+
+	getGlobalApplicatonCommands := &disgo.GetGlobalApplicationCommands{}
+	currentCommands, err := getGlobalApplicatonCommands.Send(bot)
+	if err != nil {
+		t.Fatalf("add commands: confirmation: %v", err)
+	}
+
+	if len(currentCommands) != 2 {
+		t.Fatal("add commands: confirmation: amount of global application commands is not 2")
+	}
+
+	var mainCommandID string
+	for _, command := range currentCommands {
+		if command.Name == "main" {
+			mainCommandID = command.ID
+		}
+	}
+
+	// global defined command update and delete
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{
+		{
+			Name:        "main",
+			Description: disgo.Pointer("An updated basic command."),
+		},
+	}
+
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot, bulk); err != nil {
+		t.Fatalf("update commands: %v", err)
+	}
+
+	currentCommands, err = getGlobalApplicatonCommands.Send(bot)
+	if err != nil {
+		t.Fatalf("update commands: confirmation: %v", err)
+	}
+
+	if len(currentCommands) != 1 {
+		t.Fatal("update commands: confirmation: amount of global application commands is not 1")
+	}
+
+	if currentCommands[0].ID != mainCommandID || currentCommands[0].Description != "An updated basic command." {
+		t.Fatalf("update commands: confirmation: unexpected application command %v", currentCommands[0])
+	}
+
+	// global defined command reset
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{}
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot, bulk); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+}
+
+// TestUnreachableGuilds tests synchronization of guild application commands defined for guilds the bot is not in.
+func TestUnreachableGuilds(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	restoreDefinitions(t)
+
+	bot := &disgo.Client{
+		ApplicationID:  os.Getenv("APPID"),
+		Authentication: disgo.BotToken(os.Getenv("TOKEN")),
+		Config:         disgo.DefaultConfig(),
+	}
+
+	// guild defined command for a guild the bot is not in
+	disgoform.GuildApplicationCommands = []disgo.CreateGuildApplicationCommand{
+		{
+			GuildID:     "1",
+			Name:        "main",
+			Description: disgo.Pointer("A basic command."),
+		},
+	}
+
+	_, err := disgoform.SyncGuildApplicationCommands(bot)
+
+	var unreachable *disgoform.UnreachableGuildError
+	if !errors.As(err, &unreachable) {
+		t.Fatalf("unreachable guild: expected *UnreachableGuildError, got: %v", err)
+	}
+
+	if len(unreachable.Guilds) != 1 || unreachable.Guilds[0].GuildID != "1" || len(unreachable.Guilds[0].Commands) != 1 {
+		t.Fatalf("unreachable guild: unexpected unreachable guilds: %v", unreachable)
+	}
+
+	// guild defined command for a guild the bot is not in (warning)
+	if _, err := disgoform.SyncGuildApplicationCommands(bot, disgoform.WarnUnreachableGuilds(), disgoform.DryRun()); err != nil {
+		t.Fatalf("unreachable guild warning: %v", err)
+	}
+
+	// guild defined command reset
+	disgoform.GuildApplicationCommands = []disgo.CreateGuildApplicationCommand{}
+}
+
+// TestGuildPolicy tests the WithGuildPolicy() option.
+func TestGuildPolicy(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	restoreDefinitions(t)
+
+	bot := &disgo.Client{
+		ApplicationID:  os.Getenv("APPID"),
+		Authentication: disgo.BotToken(os.Getenv("TOKEN")),
+		Config:         disgo.DefaultConfig(),
+	}
+
+	guildid := os.Getenv("GUILDID")
+
+	// guild defined command from no state
+	disgoform.GuildApplicationCommands = []disgo.CreateGuildApplicationCommand{
+		{
+			GuildID:     guildid,
+			Name:        "main",
+			Description: disgo.Pointer("A basic command."),
+		},
+	}
+
+	if _, err := disgoform.SyncGuildApplicationCommands(bot); err != nil {
+		t.Fatalf("add command: %v", err)
+	}
+
+	// guild defined commands removed from an undeclared guild
+	disgoform.GuildApplicationCommands = []disgo.CreateGuildApplicationCommand{}
+	if _, err := disgoform.SyncGuildApplicationCommands(bot, disgoform.WithGuildPolicy(disgoform.GuildPolicyDeclared)); err != nil {
+		t.Fatalf("declared policy: %v", err)
+	}
+
+	getGuildApplicatonCommands := &disgo.GetGuildApplicationCommands{GuildID: guildid}
+	currentCommands, err := getGuildApplicatonCommands.Send(bot)
+	if err != nil {
+		t.Fatalf("declared policy: confirmation: %v", err)
+	}
+
+	if len(currentCommands) != 1 {
+		t.Fatal("declared policy: confirmation: amount of guild application commands is not 1")
+	}
+
+	// guild defined commands removed from a guild which is not in the allowlist
+	if _, err := disgoform.SyncGuildApplicationCommands(bot, disgoform.WithGuildPolicy(disgoform.GuildPolicyAllowlist, "1")); err != nil {
+		t.Fatalf("allowlist policy: %v", err)
+	}
+
+	currentCommands, err = getGuildApplicatonCommands.Send(bot)
+	if err != nil {
+		t.Fatalf("allowlist policy: confirmation: %v", err)
+	}
+
+	if len(currentCommands) != 1 {
+		t.Fatal("allowlist policy: confirmation: amount of guild application commands is not 1")
+	}
+
+	// guild defined command reset
+	if _, err := disgoform.SyncGuildApplicationCommands(bot); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+}
+
+// TestGuildApplicationCommandTemplates tests synchronizing guild application command templates.
+func TestGuildApplicationCommandTemplates(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	restoreDefinitions(t)
+
+	bot := &disgo.Client{
+		ApplicationID:  os.Getenv("APPID"),
+		Authentication: disgo.BotToken(os.Getenv("TOKEN")),
+		Config:         disgo.DefaultConfig(),
+	}
+
+	guildid := os.Getenv("GUILDID")
+
+	selected := true
+
+	templates := []disgoform.GuildApplicationCommandTemplate{
+		{
+			Command: disgo.CreateGuildApplicationCommand{ //nolint:exhaustruct
+				Name:        "premium",
+				Description: disgo.Pointer("A premium command."),
+			},
+			GuildIDs: nil,
+			Selector: func(_ context.Context, guildID string) (bool, error) {
+				return selected && guildID == guildid, nil
+			},
+		},
+	}
+
+	// template command created in a selected guild with a command which is not templated.
+	syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client: bot,
+		GuildApplicationCommands: []disgo.CreateGuildApplicationCommand{
+			{
+				GuildID:     guildid,
+				Name:        "main",
+				Description: disgo.Pointer("A basic command."),
+			},
+		},
+		GuildApplicationCommandTemplates: templates,
+	})
+
+	if _, err := syncer.SyncGuildApplicationCommands(); err != nil {
+		t.Fatalf("selected: %v", err)
+	}
+
+	getGuildApplicatonCommands := &disgo.GetGuildApplicationCommands{GuildID: guildid}
+	currentCommands, err := getGuildApplicatonCommands.Send(bot)
+	if err != nil {
+		t.Fatalf("selected: confirmation: %v", err)
+	}
+
+	if len(currentCommands) != 2 {
+		t.Fatal("selected: confirmation: amount of guild application commands is not 2")
+	}
+
+	// template command removed from a guild which stops matching, while the other command is kept.
+	selected = false
+
+	syncer = disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client:                           bot,
+		GuildApplicationCommandTemplates: templates,
+	})
+
+	if _, err := syncer.SyncGuildApplicationCommands(disgoform.WithGuildPolicy(disgoform.GuildPolicyDeclared)); err != nil {
+		t.Fatalf("unselected: %v", err)
+	}
+
+	currentCommands, err = getGuildApplicatonCommands.Send(bot)
+	if err != nil {
+		t.Fatalf("unselected: confirmation: %v", err)
+	}
+
+	if len(currentCommands) != 1 || currentCommands[0].Name != "main" {
+		t.Fatal("unselected: confirmation: guild application commands are not [main]")
+	}
+
+	// guild defined command reset
+	disgoform.GuildApplicationCommands = []disgo.CreateGuildApplicationCommand{}
+	if _, err := disgoform.SyncGuildApplicationCommands(bot); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+}
+
+// TestOwnership tests synchronization which leaves application commands that are not owned untouched.
+func TestOwnership(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	bot := &disgo.Client{
+		ApplicationID:  os.Getenv("APPID"),
+		Authentication: disgo.BotToken(os.Getenv("TOKEN")),
+		Config:         disgo.DefaultConfig(),
+	}
+
+	// foreign command created by another service.
+	foreign := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client: bot,
+		GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+			{
+				Name:        "foreign",
+				Description: disgo.Pointer("A foreign command."),
+			},
+		},
+	})
+
+	if _, err := foreign.SyncGlobalApplicationCommands(); err != nil {
+		t.Fatalf("foreign: %v", err)
+	}
+
+	// owned commands synchronized using a prefix.
+	syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client: bot,
+		GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+			{
+				Name:        "owned-main",
+				Description: disgo.Pointer("An owned command."),
+			},
+		},
+	})
+
+	result, err := syncer.SyncGlobalApplicationCommands(disgoform.WithOwnedPrefix("owned-"))
+	if err != nil {
+		t.Fatalf("prefix: %v", err)
+	}
+
+	if len(result.Scopes) != 1 || len(result.Scopes[0].Created) != 1 || len(result.Scopes[0].Unmanaged) != 1 {
+		t.Fatalf("prefix: expected one created and one unmanaged command, got: %v", result)
+	}
+
+	// owned commands synchronized using a state file.
+	state := filepath.Join(t.TempDir(), "disgoform.state.json")
+
+	// owned-main exists, but is not recorded in the state file.
+	if _, err := syncer.SyncGlobalApplicationCommands(disgoform.WithStateFile(state)); err == nil {
+		t.Fatal("state file: expected a conflict with an application command which is not recorded")
+	}
+
+	if _, err := syncer.SyncGlobalApplicationCommands(disgoform.WithStateFile(state), disgoform.AdoptCommands()); err != nil {
+		t.Fatalf("state file: adopt: %v", err)
+	}
+
+	syncer.Config.GlobalApplicationCommands = nil
+
+	result, err = syncer.SyncGlobalApplicationCommands(disgoform.WithStateFile(state))
+	if err != nil {
+		t.Fatalf("state file: delete: %v", err)
+	}
+
+	if len(result.Scopes) != 1 || len(result.Scopes[0].Deleted) != 1 || result.Scopes[0].Deleted[0].Name != "owned-main" {
+		t.Fatalf("state file: delete: expected owned-main to be deleted, got: %v", result)
+	}
+
+	// foreign command reset
+	foreign.Config.GlobalApplicationCommands = nil
+	if _, err := foreign.SyncGlobalApplicationCommands(); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+}
+
+// TestDeletionProtection tests the deletion protection and destruction of application commands.
+func TestDeletionProtection(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	bot := &disgo.Client{
+		ApplicationID:  os.Getenv("APPID"),
+		Authentication: disgo.BotToken(os.Getenv("TOKEN")),
+		Config:         disgo.DefaultConfig(),
+	}
+
+	syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client: bot,
+		GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+			{
+				Name:        "main",
+				Description: disgo.Pointer("A protected command."),
+			},
+		},
+		PreventDestroy: []disgoform.ProtectedCommand{
+			{GuildID: "", Name: "main", Type: nil},
+		},
+	})
+
+	if _, err := syncer.SyncGlobalApplicationCommands(); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	syncer.Config.GlobalApplicationCommands = nil
+
+	// protected command
+	var deletionErr *disgoform.DeletionError
+
+	if _, err := syncer.SyncGlobalApplicationCommands(); !errors.As(err, &deletionErr) || len(deletionErr.Protected) != 1 {
+		t.Fatalf("protected: expected a DeletionError, got: %v", err)
+	}
+
+	if _, err := syncer.Destroy(bot.ApplicationID); !errors.As(err, &deletionErr) {
+		t.Fatalf("protected: destroy: expected a DeletionError, got: %v", err)
+	}
+
+	// maximum deletions
+	syncer.Config.PreventDestroy = nil
+
+	if _, err := syncer.SyncGlobalApplicationCommands(disgoform.WithMaxDeletions(0)); !errors.As(err, &deletionErr) || deletionErr.Deletions != 1 {
+		t.Fatalf("max deletions: expected a DeletionError, got: %v", err)
+	}
+
+	// confirmed destruction
+	if _, err := syncer.Destroy(""); err == nil {
+		t.Fatal("destroy: expected a confirmation error")
+	}
+
+	result, err := syncer.Destroy(bot.ApplicationID, disgoform.WithMaxDeletions(0))
+	if err != nil {
+		t.Fatalf("destroy: %v", err)
+	}
+
+	if len(result.Scopes) == 0 || len(result.Scopes[0].Deleted) != 1 {
+		t.Fatalf("destroy: expected main to be deleted, got: %v", result)
+	}
+}
+
+// TestSyncer tests synchronization using a Syncer.
+func TestSyncer(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	restoreDefinitions(t)
+
+	syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client: &disgo.Client{
+			ApplicationID:  os.Getenv("APPID"),
+			Authentication: disgo.BotToken(os.Getenv("TOKEN")),
+			Config:         disgo.DefaultConfig(),
+		},
+		GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+			{
+				Name:        "main",
+				Description: disgo.Pointer("A basic command."),
+			},
+		},
+	})
+
+	// package-level defined commands are not used by a Syncer.
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{}
+
+	if _, err := syncer.SyncGlobalApplicationCommands(); err != nil {
+		t.Fatalf("add command: %v", err)
+	}
+
+	getGlobalApplicatonCommands := &disgo.GetGlobalApplicationCommands{}
+	currentCommands, err := getGlobalApplicatonCommands.Send(syncer.Config.Client)
+	if err != nil {
+		t.Fatalf("add command: confirmation: %v", err)
+	}
+
+	if len(currentCommands) != 1 {
+		t.Fatal("add command: confirmation: amount of global application commands is not 1")
+	}
+
+	plan, err := syncer.PlanGlobalApplicationCommands()
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+
+	if plan.HasChanges() {
+		t.Fatalf("plan: expected no changes, got:\n%v", plan)
+	}
+
+	// global defined command reset
+	syncer.Config.GlobalApplicationCommands = nil
+	if _, err := syncer.SyncGlobalApplicationCommands(); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+}
+
+// TestContinueOnError tests the ContinueOnError() option.
+func TestContinueOnError(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	restoreDefinitions(t)
+
+	bot := &disgo.Client{
+		ApplicationID:  os.Getenv("APPID"),
+		Authentication: disgo.BotToken(os.Getenv("TOKEN")),
+		Config:         disgo.DefaultConfig(),
+	}
+
+	// global defined commands with an invalid command
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{
+		{
+			Name:        "INVALID NAME",
+			Description: disgo.Pointer("An invalid command."),
+		},
+		{
+			Name:        "main",
+			Description: disgo.Pointer("A basic command."),
+		},
+	}
+
+	result, err := disgoform.SyncGlobalApplicationCommands(bot, disgoform.ContinueOnError())
+
+	var aggregateErr *disgoform.AggregateError
+	if !errors.As(err, &aggregateErr) {
+		t.Fatalf("continue on error: expected *AggregateError, got: %v", err)
+	}
+
+	if len(aggregateErr.Errors) != 1 || aggregateErr.Errors[0].Command.Name != "INVALID NAME" {
+		t.Fatalf("continue on error: unexpected errors: %v", aggregateErr)
+	}
+
+	if len(result.Operations) != 1 || result.Operations[0].Name != "main" {
+		t.Fatalf("continue on error: unexpected result:\n%v", result)
+	}
+
+	// global defined command reset
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{}
+	if _, err := disgoform.SyncGlobalApplicationCommands(bot); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+}
+
+// TestResult tests the Result of a synchronization.
+func TestResult(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	restoreDefinitions(t)
+
+	bot := &disgo.Client{
+		ApplicationID:  os.Getenv("APPID"),
+		Authentication: disgo.BotToken(os.Getenv("TOKEN")),
+		Config:         disgo.DefaultConfig(),
+	}
+
+	// global defined commands from no state
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{
+		{
+			Name:        "main",
+			Description: disgo.Pointer("A basic command."),
+		},
+		{
+			Name:        "other",
+			Description: disgo.Pointer("Another basic command."),
+		},
+	}
+
+	result, err := disgoform.SyncGlobalApplicationCommands(bot)
+	if err != nil {
+		t.Fatalf("add commands: %v", err)
+	}
+
+	if len(result.Scopes) != 1 || len(result.Scopes[0].Created) != 2 || result.Scopes[0].Created[0].ID == "" {
+		t.Fatalf("add commands: unexpected result: %+v", result.Scopes)
+	}
+
+	createdID := result.Scopes[0].Created[0].ID
+
+	// global defined command update and unchanged command
+	disgoform.GlobalApplicationCommands[0].Description = disgo.Pointer("An updated basic command.")
+
+	result, err = disgoform.SyncGlobalApplicationCommands(bot)
+	if err != nil {
+		t.Fatalf("update command: %v", err)
+	}
+
+	scope := result.Scopes[0]
+	if len(scope.Updated) != 1 || len(scope.Unchanged) != 1 || scope.Updated[0].ID != createdID {
+		t.Fatalf("update command: unexpected result: %+v", scope)
+	}
+
+	if _, err := json.Marshal(result); err != nil {
+		t.Fatalf("update command: json: %v", err)
+	}
+
+	// global defined command reset
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{}
+
+	result, err = disgoform.SyncGlobalApplicationCommands(bot)
+	if err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+
+	if len(result.Scopes[0].Deleted) != 2 {
+		t.Fatalf("reset: unexpected result: %+v", result.Scopes[0])
+	}
+}
+
+// TestImport tests Import() functionality.
+func TestImport(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	restoreDefinitions(t)
+
+	bot := &disgo.Client{
+		ApplicationID:  os.Getenv("APPID"),
+		Authentication: disgo.BotToken(os.Getenv("TOKEN")),
+		Config:         disgo.DefaultConfig(),
+	}
+
+	guildid := os.Getenv("GUILDID")
+
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{
+		{
+			Name:        "main",
+			Description: disgo.Pointer("A basic command."),
+		},
+	}
+
+	disgoform.GuildApplicationCommands = []disgo.CreateGuildApplicationCommand{
+		{
+			GuildID:     guildid,
+			Name:        "guild",
+			Description: disgo.Pointer("A guild command."),
+		},
+	}
+
+	if _, err := disgoform.Sync(bot); err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+
+	definitions, err := disgoform.Import(bot, []string{guildid})
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+
+	data, err := disgoform.DefinitionFile("commands.yaml", definitions)
+	if err != nil {
+		t.Fatalf("definition file: %v", err)
+	}
+
+	loaded, err := disgoform.Load("commands.yaml", data)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	// synchronizing the imported application commands must not change any application command.
+	syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client:                    bot,
+		GlobalApplicationCommands: loaded.GlobalApplicationCommands,
+		GuildApplicationCommands:  loaded.GuildApplicationCommands,
+	})
+
+	plan, err := syncer.Plan()
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+
+	if plan.HasChanges() {
+		t.Fatalf("expected no changes after import, got:\n%v", plan)
+	}
+}
+
+// TestApplicationCommandPermissions tests SyncApplicationCommandPermissions() functionality.
+func TestApplicationCommandPermissions(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	bearer := os.Getenv("BEARER_TOKEN")
+	if bearer == "" {
+		t.Skip("BEARER_TOKEN (applications.commands.permissions.update) is not set")
+	}
+
+	bot := &disgo.Client{
+		ApplicationID:  os.Getenv("APPID"),
+		Authentication: disgo.BotToken(os.Getenv("TOKEN")),
+		Config:         disgo.DefaultConfig(),
+	}
+
+	guildid := os.Getenv("GUILDID")
+
+	allChannels, err := disgoform.AllChannelsPermissionID(guildid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client: bot,
+		GuildApplicationCommands: []disgo.CreateGuildApplicationCommand{
+			{
+				GuildID:     guildid,
+				Name:        "main",
+				Description: disgo.Pointer("A basic command."),
+			},
+		},
+		ApplicationCommandPermissions: []disgoform.CommandPermissions{
+			{
+				GuildID: guildid,
+				Name:    "main",
+				Permissions: []*disgo.ApplicationCommandPermissions{
+					{ID: disgoform.EveryonePermissionID(guildid), Type: disgo.FlagApplicationCommandPermissionTypeROLE, Permission: false},
+					{ID: allChannels, Type: disgo.FlagApplicationCommandPermissionTypeCHANNEL, Permission: true},
+				},
+			},
+		},
+	})
+
+	// permissions without a Bearer token
+	if _, err := syncer.Sync(); !errors.Is(err, disgoform.ErrPermissionsAuthentication) {
+		t.Fatalf("expected ErrPermissionsAuthentication, got %v", err)
+	}
+
+	// permissions are edited after the application command is created.
+	result, err := syncer.Sync(disgoform.WithPermissionsAuthentication(disgo.BearerToken(bearer)))
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+
+	if len(result.Permissions) != 1 || result.Permissions[0].CommandID == "" {
+		t.Fatalf("expected permissions of one application command, got %v", result.Permissions)
+	}
+
+	// permissions which are synchronized are not edited.
+	result, err = syncer.SyncApplicationCommandPermissions(disgoform.WithPermissionsAuthentication(disgo.BearerToken(bearer)))
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+
+	if result.Permissions[0].Action != disgoform.ActionNoOp {
+		t.Fatalf("expected no-op, got %v", result.Permissions[0].Action)
+	}
+}
@@ -1,8 +1,26 @@
 package tests
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/rs/zerolog"
 	"github.com/switchupcb/disgo"
 	"github.com/switchupcb/disgoform"
 )
@@ -33,7 +51,7 @@ func TestCommandComparisons(t *testing.T) {
 				Contexts:                 []disgo.Flag{},
 			},
 			b:        disgo.CreateGlobalApplicationCommand{},
-			expected: false,
+			expected: true,
 		},
 		{
 			name: "pointer",
@@ -152,6 +170,237 @@ func TestCommandComparisons(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "default-type",
+			a: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+				Type: disgo.Pointer(disgo.FlagApplicationCommandTypeCHAT_INPUT),
+			},
+			b: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+			},
+			expected: true,
+		},
+		{
+			name: "diff-type",
+			a: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+				Type: disgo.Pointer(disgo.FlagApplicationCommandTypeMESSAGE),
+			},
+			b: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+			},
+			expected: false,
+		},
+		{
+			name: "default-nsfw",
+			a: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+				NSFW: disgo.Pointer(false),
+			},
+			b: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+			},
+			expected: true,
+		},
+		{
+			name: "default-permissions",
+			a: disgo.CreateGlobalApplicationCommand{
+				Name:                     "main",
+				DefaultMemberPermissions: disgo.Pointer2("", true),
+			},
+			b: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+			},
+			expected: true,
+		},
+		{
+			name: "default-integration-types",
+			a: disgo.CreateGlobalApplicationCommand{
+				Name:             "main",
+				IntegrationTypes: []disgo.Flag{disgo.FlagApplicationIntegrationTypeGUILD_INSTALL},
+			},
+			b: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+			},
+			expected: true,
+		},
+		{
+			name: "diff-integration-types",
+			a: disgo.CreateGlobalApplicationCommand{
+				Name:             "main",
+				IntegrationTypes: []disgo.Flag{disgo.FlagApplicationIntegrationTypeUSER_INSTALL},
+			},
+			b: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+			},
+			expected: false,
+		},
+		{
+			name: "unordered-contexts",
+			a: disgo.CreateGlobalApplicationCommand{
+				Name:     "main",
+				Contexts: []disgo.Flag{disgo.FlagInteractionContextTypeBOT_DM, disgo.FlagInteractionContextTypeGUILD},
+			},
+			b: disgo.CreateGlobalApplicationCommand{
+				Name:     "main",
+				Contexts: []disgo.Flag{disgo.FlagInteractionContextTypeGUILD, disgo.FlagInteractionContextTypeBOT_DM},
+			},
+			expected: true,
+		},
+		{
+			name: "default-option-required",
+			a: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+				Options: []*disgo.ApplicationCommandOption{
+					{
+						Name:         "confirm",
+						Description:  "Confirm your answer.",
+						Type:         disgo.FlagApplicationCommandOptionTypeSTRING,
+						Required:     disgo.Pointer(false),
+						Autocomplete: disgo.Pointer(false),
+						Choices:      []*disgo.ApplicationCommandOptionChoice{},
+					},
+				},
+			},
+			b: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+				Options: []*disgo.ApplicationCommandOption{
+					{
+						Name:        "confirm",
+						Description: "Confirm your answer.",
+						Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "ordered-options",
+			a: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+				Options: []*disgo.ApplicationCommandOption{
+					{Name: "a", Description: "a", Type: disgo.FlagApplicationCommandOptionTypeSTRING},
+					{Name: "b", Description: "b", Type: disgo.FlagApplicationCommandOptionTypeSTRING},
+				},
+			},
+			b: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+				Options: []*disgo.ApplicationCommandOption{
+					{Name: "b", Description: "b", Type: disgo.FlagApplicationCommandOptionTypeSTRING},
+					{Name: "a", Description: "a", Type: disgo.FlagApplicationCommandOptionTypeSTRING},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "ordered-choices",
+			a: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+				Options: []*disgo.ApplicationCommandOption{
+					{
+						Name:        "a",
+						Description: "a",
+						Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+						Choices: []*disgo.ApplicationCommandOptionChoice{
+							{Name: "One", Value: "1"},
+							{Name: "Two", Value: "2"},
+						},
+					},
+				},
+			},
+			b: disgo.CreateGlobalApplicationCommand{
+				Name: "main",
+				Options: []*disgo.ApplicationCommandOption{
+					{
+						Name:        "a",
+						Description: "a",
+						Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+						Choices: []*disgo.ApplicationCommandOptionChoice{
+							{Name: "Two", Value: "2"},
+							{Name: "One", Value: "1"},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		if got := disgoform.Equal(test.a, test.b); got != test.expected {
+			t.Errorf("(%v: got %v, wanted %v", test.name, got, test.expected)
+		}
+	}
+}
+
+// testGuildCommandComparisons represents parameters used to test guild application commands comparisons.
+type testGuildCommandComparisons struct {
+	name     string
+	a        disgo.CreateGuildApplicationCommand
+	b        disgo.CreateGuildApplicationCommand
+	expected bool
+}
+
+// TestGuildCommandComparisons tests guild application commands comparisons.
+func TestGuildCommandComparisons(t *testing.T) {
+	tests := []testGuildCommandComparisons{
+		{
+			name: "basic",
+			a: disgo.CreateGuildApplicationCommand{
+				NameLocalizations:        &map[string]string{},
+				Description:              new(string),
+				DescriptionLocalizations: &map[string]string{},
+				DefaultMemberPermissions: disgo.Pointer2("", true),
+				Type:                     disgo.Pointer(disgo.FlagApplicationCommandTypeCHAT_INPUT),
+				NSFW:                     disgo.Pointer(false),
+				GuildID:                  "0",
+				Name:                     "",
+				Options:                  []*disgo.ApplicationCommandOption{},
+			},
+			b: disgo.CreateGuildApplicationCommand{
+				GuildID: "0",
+			},
+			expected: true,
+		},
+		{
+			name: "diff-guild",
+			a: disgo.CreateGuildApplicationCommand{
+				GuildID: "0",
+				Name:    "main",
+			},
+			b: disgo.CreateGuildApplicationCommand{
+				GuildID: "1",
+				Name:    "main",
+			},
+			expected: false,
+		},
+		{
+			name: "diff-permissions",
+			a: disgo.CreateGuildApplicationCommand{
+				GuildID:                  "0",
+				Name:                     "main",
+				DefaultMemberPermissions: disgo.Pointer2("8"),
+			},
+			b: disgo.CreateGuildApplicationCommand{
+				GuildID: "0",
+				Name:    "main",
+			},
+			expected: false,
+		},
+		{
+			name: "diff-description",
+			a: disgo.CreateGuildApplicationCommand{
+				GuildID:     "0",
+				Name:        "main",
+				Description: disgo.Pointer("A basic command."),
+			},
+			b: disgo.CreateGuildApplicationCommand{
+				GuildID: "0",
+				Name:    "main",
+			},
+			expected: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -160,3 +409,1815 @@ func TestCommandComparisons(t *testing.T) {
 		}
 	}
 }
+
+// testCommandDiffs represents parameters used to test application command diffs.
+type testCommandDiffs struct {
+	name     string
+	current  disgo.CreateGlobalApplicationCommand
+	defined  disgo.CreateGlobalApplicationCommand
+	expected string
+}
+
+// TestCommandDiffs tests application command diffs.
+func TestCommandDiffs(t *testing.T) {
+	options := func(choice string) []*disgo.ApplicationCommandOption {
+		return []*disgo.ApplicationCommandOption{
+			{
+				Name:        "confirm",
+				Description: "Confirm your answer.",
+				Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+			},
+			{
+				Name:        "freewill",
+				Description: "Do you have it?",
+				Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+				Choices: []*disgo.ApplicationCommandOptionChoice{
+					{
+						Name:  choice,
+						Value: "y",
+					},
+				},
+			},
+		}
+	}
+
+	tests := []testCommandDiffs{
+		{
+			name:     "equal",
+			current:  disgo.CreateGlobalApplicationCommand{Name: "main", Options: options("Yes")},
+			defined:  disgo.CreateGlobalApplicationCommand{Name: "main", Options: options("Yes")},
+			expected: "",
+		},
+		{
+			name:     "nested-choice",
+			current:  disgo.CreateGlobalApplicationCommand{Name: "main", Options: options("Yes")},
+			defined:  disgo.CreateGlobalApplicationCommand{Name: "main", Options: options("Yeah")},
+			expected: "~ options[1].choices[0].name: \"Yes\" -> \"Yeah\"\n",
+		},
+		{
+			name:     "added-description",
+			current:  disgo.CreateGlobalApplicationCommand{Name: "main"},
+			defined:  disgo.CreateGlobalApplicationCommand{Name: "main", Description: disgo.Pointer("A basic command.")},
+			expected: "+ description: null -> \"A basic command.\"\n",
+		},
+		{
+			name: "removed-localization",
+			current: disgo.CreateGlobalApplicationCommand{
+				Name:              "hello",
+				NameLocalizations: &map[string]string{disgo.FlagLocalesEnglishUK: "mate", disgo.FlagLocalesEnglishUS: "hello"},
+			},
+			defined: disgo.CreateGlobalApplicationCommand{
+				Name:              "hello",
+				NameLocalizations: &map[string]string{disgo.FlagLocalesEnglishUK: "mate"},
+			},
+			expected: "- name_localizations[\"en-US\"]: \"hello\" -> null\n",
+		},
+		{
+			name:     "permissions",
+			current:  disgo.CreateGlobalApplicationCommand{Name: "main", DefaultMemberPermissions: disgo.Pointer2("0")},
+			defined:  disgo.CreateGlobalApplicationCommand{Name: "main", DefaultMemberPermissions: disgo.Pointer2("8")},
+			expected: "~ default_member_permissions: \"0\" -> \"8\"\n",
+		},
+	}
+
+	for _, test := range tests {
+		if got := disgoform.DiffGlobalApplicationCommands(test.current, test.defined).String(); got != test.expected {
+			t.Errorf("%v: got %q, wanted %q", test.name, got, test.expected)
+		}
+	}
+}
+
+// TestConfigFile tests ConfigFile() functionality.
+func TestConfigFile(t *testing.T) {
+	globalCommands := []disgo.CreateGlobalApplicationCommand{
+		{
+			Name:                     "autocomplete",
+			NameLocalizations:        &map[string]string{},
+			Description:              disgo.Pointer("Learn about autocompletion."),
+			DefaultMemberPermissions: disgo.Pointer2("", true),
+			Type:                     disgo.Pointer(disgo.FlagApplicationCommandTypeCHAT_INPUT),
+			NSFW:                     disgo.Pointer(false),
+			IntegrationTypes:         []disgo.Flag{disgo.FlagApplicationIntegrationTypeGUILD_INSTALL},
+			Options: []*disgo.ApplicationCommandOption{
+				{
+					Name:        "freewill",
+					Description: "Do you have it?",
+					Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+					Required:    disgo.Pointer(true),
+					Choices: []*disgo.ApplicationCommandOptionChoice{
+						{
+							Name:  "Yes",
+							Value: "y",
+						},
+					},
+				},
+				{
+					Name:        "amount",
+					Description: "How much?",
+					Type:        disgo.FlagApplicationCommandOptionTypeNUMBER,
+					Required:    disgo.Pointer(false),
+					MinValue:    disgo.Pointer(1.0),
+				},
+			},
+		},
+	}
+
+	guildCommands := []disgo.CreateGuildApplicationCommand{
+		{
+			GuildID:                  "0",
+			Name:                     "report",
+			Description:              new(string),
+			DefaultMemberPermissions: disgo.Pointer2("8"),
+			Type:                     disgo.Pointer(disgo.FlagApplicationCommandTypeMESSAGE),
+		},
+	}
+
+	expected := `package main
+
+import (
+	"github.com/switchupcb/disgo"
+	"github.com/switchupcb/disgoform"
+)
+
+// init defines the bot's application commands.
+func init() {
+	disgoform.GlobalApplicationCommands = []disgo.CreateGlobalApplicationCommand{
+		{
+			Name:        "autocomplete",
+			Description: disgo.Pointer("Learn about autocompletion."),
+			Type:        disgo.Pointer(disgo.FlagApplicationCommandTypeCHAT_INPUT),
+			Options: []*disgo.ApplicationCommandOption{
+				{
+					Name:        "freewill",
+					Description: "Do you have it?",
+					Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+					Required:    disgo.Pointer(true),
+					Choices: []*disgo.ApplicationCommandOptionChoice{
+						{
+							Name:  "Yes",
+							Value: "y",
+						},
+					},
+				},
+				{
+					Name:        "amount",
+					Description: "How much?",
+					Type:        disgo.FlagApplicationCommandOptionTypeNUMBER,
+					MinValue:    disgo.Pointer(1.0),
+				},
+			},
+		},
+	}
+
+	disgoform.GuildApplicationCommands = []disgo.CreateGuildApplicationCommand{
+		{
+			GuildID:                  "0",
+			Name:                     "report",
+			Type:                     disgo.Pointer(disgo.FlagApplicationCommandTypeMESSAGE),
+			DefaultMemberPermissions: disgo.Pointer2("8"),
+		},
+	}
+}
+`
+
+	got, err := disgoform.ConfigFile(globalCommands, guildCommands)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if got != expected {
+		t.Errorf("got:\n%v\nwanted:\n%v", got, expected)
+	}
+}
+
+// TestSyncCanceled tests synchronization using a canceled context.
+func TestSyncCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client: &disgo.Client{ //nolint:exhaustruct
+			ApplicationID: "0",
+		},
+		GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+			{
+				Name:        "main",
+				Description: disgo.Pointer("A basic command."),
+			},
+		},
+	})
+
+	result, err := syncer.SyncContext(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+
+	if result == nil || len(result.Operations) != 0 {
+		t.Fatalf("expected empty partial result, got: %v", result)
+	}
+}
+
+// TestOwnershipOptions tests the ownership options which do not require Discord.
+func TestOwnershipOptions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client: &disgo.Client{ //nolint:exhaustruct
+			ApplicationID: "0",
+		},
+		GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+			{
+				Name:        "main",
+				Description: disgo.Pointer("A basic command."),
+			},
+		},
+	})
+
+	// a defined application command must be owned.
+	_, err := syncer.PlanGlobalApplicationCommandsContext(ctx, disgoform.WithOwnedPrefix("billing-"))
+	if err == nil || !strings.Contains(err.Error(), `cannot define CHAT_INPUT command "main" which is not owned`) {
+		t.Fatalf("prefix: expected an ownership error, got: %v", err)
+	}
+
+	_, err = syncer.PlanGlobalApplicationCommandsContext(ctx, disgoform.WithOwnedNames("main"))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("names: expected context.Canceled, got: %v", err)
+	}
+
+	// a defined application command is owned by a state file, which must be valid.
+	name := filepath.Join(t.TempDir(), "disgoform.state.json")
+
+	_, err = syncer.PlanGlobalApplicationCommandsContext(ctx, disgoform.WithOwnedPrefix("billing-"), disgoform.WithStateFile(name))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("state file: expected context.Canceled, got: %v", err)
+	}
+
+	if err := os.WriteFile(name, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = syncer.PlanGlobalApplicationCommandsContext(ctx, disgoform.WithStateFile(name))
+	if err == nil || errors.Is(err, context.Canceled) {
+		t.Fatalf("invalid state file: expected a state file error, got: %v", err)
+	}
+}
+
+// TestOwnershipConflict tests that a current application command which is not recorded in a state file is only edited when it's adopted.
+func TestOwnershipConflict(t *testing.T) {
+	bot, discord := newFakeDiscord(t, map[string]string{
+		"/api/v10/applications/0/commands": `[
+			{"id": "1", "application_id": "0", "name": "main", "description": "A command of another service.", "version": "1", "type": 1}
+		]`,
+	})
+
+	syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client: bot,
+		GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+			{Name: "main", Description: disgo.Pointer("A command of another service.")}, //nolint:exhaustruct
+		},
+	})
+
+	name := filepath.Join(t.TempDir(), "disgoform.state.json")
+
+	_, err := syncer.PlanGlobalApplicationCommands(disgoform.WithStateFile(name))
+	if err == nil || !strings.Contains(err.Error(), `cannot edit CHAT_INPUT command "main" (1) which is not recorded in the state file`) {
+		t.Fatalf("conflict: expected an ownership error, got: %v", err)
+	}
+
+	// an adopted application command is recorded, so it's owned.
+	if _, err := syncer.SyncGlobalApplicationCommands(disgoform.WithStateFile(name), disgoform.AdoptCommands()); err != nil {
+		t.Fatalf("adopt: %v", err)
+	}
+
+	if _, err := syncer.PlanGlobalApplicationCommands(disgoform.WithStateFile(name)); err != nil {
+		t.Fatalf("adopted: %v", err)
+	}
+
+	if len(discord.requests) != 0 {
+		t.Fatalf("expected no requests which modify application commands, got: %v", discord.requests)
+	}
+}
+
+// TestDeletionError tests the deletion protection of a plan and the confirmation of a destruction.
+func TestDeletionError(t *testing.T) {
+	syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client: &disgo.Client{ //nolint:exhaustruct
+			ApplicationID: "0",
+		},
+		PreventDestroy: []disgoform.ProtectedCommand{
+			{GuildID: "", Name: "main", Type: nil},
+		},
+	})
+
+	// a plan without states is applied without confirming the current application command state.
+	plan := &disgoform.ChangePlan{
+		Operations: []*disgoform.Operation{
+			{Action: disgoform.ActionDelete, Scope: disgoform.ScopeGlobal, Name: "main", Type: disgo.FlagApplicationCommandTypeCHAT_INPUT, CommandID: "1"},              //nolint:exhaustruct
+			{Action: disgoform.ActionDelete, Scope: disgoform.ScopeGuild, GuildID: "1", Name: "main", Type: disgo.FlagApplicationCommandTypeCHAT_INPUT, CommandID: "2"}, //nolint:exhaustruct
+		},
+		States: nil,
+	}
+
+	var deletionErr *disgoform.DeletionError
+
+	_, err := syncer.Apply(plan, disgoform.DryRun())
+	if !errors.As(err, &deletionErr) || len(deletionErr.Protected) != 1 || deletionErr.Protected[0].CommandID != "1" {
+		t.Fatalf("protected: expected a DeletionError of the global command, got: %v", err)
+	}
+
+	_, err = syncer.Apply(plan, disgoform.DryRun(), disgoform.WithMaxDeletions(1))
+	if !errors.As(err, &deletionErr) || deletionErr.Deletions != 2 || deletionErr.MaxDeletions != 1 {
+		t.Fatalf("max deletions: expected a DeletionError of 2 deletions, got: %v", err)
+	}
+
+	syncer.Config.PreventDestroy = nil
+
+	result, err := syncer.Apply(plan, disgoform.DryRun(), disgoform.WithMaxDeletions(2))
+	if err != nil || len(result.Operations) != 2 {
+		t.Fatalf("unprotected: expected 2 planned deletions, got: %v", err)
+	}
+
+	// a recreate deletes the current application command.
+	plan.Operations = append(plan.Operations, &disgoform.Operation{ //nolint:exhaustruct
+		Action: disgoform.ActionRecreate, Scope: disgoform.ScopeGlobal, Name: "other", Type: disgo.FlagApplicationCommandTypeMESSAGE, CommandID: "3",
+	})
+
+	_, err = syncer.Apply(plan, disgoform.DryRun(), disgoform.WithMaxDeletions(2))
+	if !errors.As(err, &deletionErr) || deletionErr.Deletions != 3 || len(deletionErr.Protected) != 0 {
+		t.Fatalf("recreate: expected a DeletionError of 3 deletions, got: %v", err)
+	}
+
+	// a destruction must be confirmed using the application ID.
+	if _, err := syncer.Destroy("1"); err == nil {
+		t.Fatal("destroy: expected a confirmation error")
+	}
+}
+
+// TestPreventDestroy tests the deletion protection of the package-level functions.
+func TestPreventDestroy(t *testing.T) {
+	protected := []disgoform.ProtectedCommand{
+		{GuildID: "", Name: "main", Type: nil},
+		{GuildID: "1", Name: "", Type: nil},
+	}
+
+	// the package-level Apply uses the package-level PreventDestroy.
+	preventDestroy := disgoform.PreventDestroy
+	disgoform.PreventDestroy = protected
+
+	t.Cleanup(func() { disgoform.PreventDestroy = preventDestroy })
+
+	bot := &disgo.Client{ //nolint:exhaustruct
+		ApplicationID: "0",
+	}
+
+	plan := &disgoform.ChangePlan{
+		Operations: []*disgoform.Operation{
+			{Action: disgoform.ActionDelete, Scope: disgoform.ScopeGlobal, Name: "main", Type: disgo.FlagApplicationCommandTypeCHAT_INPUT, CommandID: "1"}, //nolint:exhaustruct
+		},
+		States: nil,
+	}
+
+	var deletionErr *disgoform.DeletionError
+
+	if _, err := disgoform.Apply(bot, plan, disgoform.DryRun()); !errors.As(err, &deletionErr) || len(deletionErr.Protected) != 1 {
+		t.Fatalf("apply: expected a DeletionError of the global command, got: %v", err)
+	}
+
+	var validationErr *disgoform.ValidationError
+
+	syncer := disgoform.NewSyncer(disgoform.Config{PreventDestroy: protected}) //nolint:exhaustruct
+
+	if err := syncer.Validate(); !errors.As(err, &validationErr) || len(validationErr.Violations) != 1 || validationErr.Violations[0].Path != "PreventDestroy[1].name" {
+		t.Fatalf("validate: expected a violation of the protected command without a name, got: %v", err)
+	}
+}
+
+// fakeDiscord represents a fake Discord API which serves the current application commands of each scope
+// and the guilds of the bot.
+type fakeDiscord struct {
+	// responses represents a map of request URIs (e.g., "/api/v10/users/@me/guilds?limit=200")
+	// or request paths (e.g., "/api/v10/applications/0/commands") to responses (JSON).
+	//
+	// The response of a request which modifies application commands is mapped by its method and path
+	// (e.g., "POST /api/v10/applications/0/commands"), or empty when it's not mapped.
+	responses map[string]string
+
+	// modified is called after each request which modifies application commands is received (when set).
+	modified func()
+
+	// requests represents the requests which modify application commands (e.g., "DELETE /api/v10/applications/0/commands/1").
+	requests []string
+
+	// bodies represents the bodies of the requests which modify application commands.
+	bodies []string
+
+	// guildRequests represents the requests which read the guilds of the bot (e.g., "/api/v10/users/@me/guilds?limit=200").
+	guildRequests []string
+}
+
+// newFakeDiscord returns a client which sends its requests to a fake Discord API.
+func newFakeDiscord(t *testing.T, responses map[string]string) (*disgo.Client, *fakeDiscord) {
+	t.Helper()
+
+	discord := &fakeDiscord{responses: responses, modified: nil, requests: nil, bodies: nil, guildRequests: nil}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method != http.MethodGet {
+			request := r.Method + " " + r.URL.Path
+			discord.requests = append(discord.requests, request)
+
+			body, _ := io.ReadAll(r.Body)
+			discord.bodies = append(discord.bodies, string(body))
+
+			if discord.modified != nil {
+				discord.modified()
+			}
+
+			if body, ok := discord.responses[request]; ok {
+				_, _ = w.Write([]byte(body))
+
+				return
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		}
+
+		if r.URL.Path == "/api/v10/users/@me/guilds" {
+			discord.guildRequests = append(discord.guildRequests, r.URL.RequestURI())
+		}
+
+		body, ok := discord.responses[r.URL.RequestURI()]
+		if !ok {
+			body, ok = discord.responses[r.URL.Path]
+		}
+
+		if !ok {
+			body = "[]"
+		}
+
+		_, _ = w.Write([]byte(body))
+	}))
+
+	t.Cleanup(server.Close)
+
+	config := disgo.DefaultConfig()
+	config.Request.Client.Dial = func(string) (net.Conn, error) {
+		return net.Dial("tcp", server.Listener.Addr().String())
+	}
+	config.Request.Client.TLSConfig = &tls.Config{InsecureSkipVerify: true} //nolint:exhaustruct,gosec
+
+	return &disgo.Client{ //nolint:exhaustruct
+		ApplicationID:  "0",
+		Authentication: disgo.BotToken("TOKEN"),
+		Config:         config,
+	}, discord
+}
+
+// TestSyncCanceledApply tests that a synchronization sends no further request after its context is canceled.
+func TestSyncCanceledApply(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bot, discord := newFakeDiscord(t, map[string]string{
+		"POST /api/v10/applications/0/commands": `{"id": "1", "application_id": "0", "name": "a", "description": "A command.", "version": "1", "type": 1}`,
+	})
+
+	// the context is canceled while the first operation is in progress.
+	discord.modified = cancel
+
+	syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client: bot,
+		GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+			{Name: "a", Description: disgo.Pointer("A command.")}, //nolint:exhaustruct
+			{Name: "b", Description: disgo.Pointer("A command.")}, //nolint:exhaustruct
+			{Name: "c", Description: disgo.Pointer("A command.")}, //nolint:exhaustruct
+		},
+	})
+
+	result, err := syncer.SyncContext(ctx, disgoform.WithGuildDiscovery(disgoform.GuildDiscoveryDeclared))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+
+	if len(discord.requests) != 1 {
+		t.Fatalf("expected the request in progress to be the last request, got: %v", discord.requests)
+	}
+
+	if result == nil || len(result.Operations) != 1 {
+		t.Fatalf("expected a partial result of the operation in progress, got: %v", result)
+	}
+}
+
+// TestGuildDiscovery tests that the guilds of the bot are discovered from every page of Get Current User Guilds requests.
+func TestGuildDiscovery(t *testing.T) {
+	// guilds returns a page of guilds with the IDs from first to last.
+	guilds := func(first, last int) string {
+		page := make([]string, 0, last-first+1)
+		for id := first; id <= last; id++ {
+			page = append(page, `{"id": "`+strconv.Itoa(id)+`", "name": "guild"}`)
+		}
+
+		return "[" + strings.Join(page, ", ") + "]"
+	}
+
+	// a full page (200 guilds) is followed by a partial page (50 guilds).
+	bot, discord := newFakeDiscord(t, map[string]string{
+		"/api/v10/users/@me/guilds?limit=200":           guilds(1, 200),
+		"/api/v10/users/@me/guilds?after=200&limit=200": guilds(201, 250),
+	})
+
+	guildIDs := []string{"1", "200", "201", "250"}
+
+	commands := make([]disgo.CreateGuildApplicationCommand, len(guildIDs))
+	for i, guildID := range guildIDs {
+		commands[i] = disgo.CreateGuildApplicationCommand{GuildID: guildID, Name: "main", Description: disgo.Pointer("A command.")} //nolint:exhaustruct
+	}
+
+	syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client:                   bot,
+		GuildApplicationCommands: commands,
+	})
+
+	plan, err := syncer.PlanGuildApplicationCommands(
+		disgoform.WithGuildDiscovery(disgoform.GuildDiscoveryREST),
+		disgoform.WithGuildPolicy(disgoform.GuildPolicyDeclared),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v (guild requests: %v)", err, discord.guildRequests)
+	}
+
+	planned := make([]string, 0, len(plan.States))
+	for _, state := range plan.States {
+		planned = append(planned, state.GuildID)
+	}
+
+	if !slices.Equal(planned, guildIDs) {
+		t.Errorf("expected planned guilds %v, got %v", guildIDs, planned)
+	}
+
+	expected := []string{
+		"/api/v10/users/@me/guilds?limit=200",
+		"/api/v10/users/@me/guilds?after=200&limit=200",
+	}
+
+	if !slices.Equal(discord.guildRequests, expected) {
+		t.Errorf("expected guild requests %v, got %v", expected, discord.guildRequests)
+	}
+}
+
+// testEntryPointHandler represents parameters used to test the entry point handler of a synchronization.
+type testEntryPointHandler struct {
+	name     string
+	current  string
+	opts     []disgoform.Option
+	requests []string
+
+	// handler represents the handler sent in the request body (empty when the handler is omitted).
+	handler string
+}
+
+// TestEntryPointHandler tests that the entry point handler of a PRIMARY_ENTRY_POINT application command is sent to Discord.
+func TestEntryPointHandler(t *testing.T) {
+	launchActivity := disgoform.WithEntryPointHandler(disgo.FlagEntryPointCommandHandlerTypesDISCORD_LAUNCH_ACTIVITY)
+
+	tests := []testEntryPointHandler{
+		{
+			name:     "create",
+			current:  `[]`,
+			opts:     []disgoform.Option{launchActivity},
+			requests: []string{"POST /api/v10/applications/0/commands"},
+			handler:  `"handler":2`,
+		},
+		{
+			name:     "create without handler",
+			current:  `[]`,
+			opts:     nil,
+			requests: []string{"POST /api/v10/applications/0/commands"},
+			handler:  "",
+		},
+		{
+			name:     "create using bulk strategy",
+			current:  `[]`,
+			opts:     []disgoform.Option{launchActivity, disgoform.WithStrategy(disgoform.StrategyBulk)},
+			requests: []string{"PUT /api/v10/applications/0/commands"},
+			handler:  `"handler":2`,
+		},
+		{
+			name:     "recreate",
+			current:  `[{"id": "1", "application_id": "0", "name": "launch", "description": "Launch the activity.", "version": "1", "type": 1}]`,
+			opts:     []disgoform.Option{launchActivity},
+			requests: []string{"DELETE /api/v10/applications/0/commands/1", "POST /api/v10/applications/0/commands"},
+			handler:  `"handler":2`,
+		},
+		{
+			name:     "update handler",
+			current:  `[{"id": "1", "application_id": "0", "name": "launch", "description": "Launch the activity.", "version": "1", "type": 4, "handler": 1}]`,
+			opts:     []disgoform.Option{launchActivity},
+			requests: []string{"PATCH /api/v10/applications/0/commands/1"},
+			handler:  `"handler":2`,
+		},
+		{
+			name:     "current handler",
+			current:  `[{"id": "1", "application_id": "0", "name": "launch", "description": "Launch the activity.", "version": "1", "type": 4, "handler": 2}]`,
+			opts:     []disgoform.Option{launchActivity},
+			requests: nil,
+			handler:  "",
+		},
+	}
+
+	for _, test := range tests {
+		bot, discord := newFakeDiscord(t, map[string]string{
+			"/api/v10/applications/0/commands": test.current,
+		})
+
+		syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+			Client: bot,
+			GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+				{Name: "launch", Description: disgo.Pointer("Launch the activity."), Type: disgo.Pointer(disgo.FlagApplicationCommandTypePRIMARY_ENTRY_POINT)}, //nolint:exhaustruct
+			},
+		})
+
+		if _, err := syncer.SyncGlobalApplicationCommands(test.opts...); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+
+			continue
+		}
+
+		if !slices.Equal(discord.requests, test.requests) {
+			t.Errorf("%s: expected requests %v, got %v", test.name, test.requests, discord.requests)
+
+			continue
+		}
+
+		if len(discord.bodies) == 0 {
+			continue
+		}
+
+		body := discord.bodies[len(discord.bodies)-1]
+
+		switch {
+		case test.handler == "" && strings.Contains(body, `"handler"`):
+			t.Errorf("%s: expected no handler, got: %s", test.name, body)
+		case !strings.Contains(body, test.handler):
+			t.Errorf("%s: expected handler %s, got: %s", test.name, test.handler, body)
+		}
+	}
+}
+
+// TestSyncMaxDeletions tests that the maximum amount of deletions applies to every scope of a synchronization.
+func TestSyncMaxDeletions(t *testing.T) {
+	bot, discord := newFakeDiscord(t, map[string]string{
+		"/api/v10/applications/0/commands": `[
+			{"id": "1", "application_id": "0", "name": "stale", "description": "A stale command.", "version": "1", "type": 1}
+		]`,
+		"/api/v10/applications/0/guilds/1/commands": `[
+			{"id": "2", "application_id": "0", "guild_id": "1", "name": "main", "description": "A command.", "version": "1", "type": 1},
+			{"id": "3", "application_id": "0", "guild_id": "1", "name": "stale", "description": "A stale command.", "version": "1", "type": 1}
+		]`,
+	})
+
+	syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+		Client: bot,
+		GuildApplicationCommands: []disgo.CreateGuildApplicationCommand{
+			{GuildID: "1", Name: "main", Description: disgo.Pointer("A command.")}, //nolint:exhaustruct
+		},
+	})
+
+	var deletionErr *disgoform.DeletionError
+
+	_, err := syncer.Sync(disgoform.WithGuildDiscovery(disgoform.GuildDiscoveryDeclared), disgoform.WithMaxDeletions(1))
+	if !errors.As(err, &deletionErr) || deletionErr.Deletions != 2 {
+		t.Fatalf("expected a DeletionError of 2 deletions, got: %v", err)
+	}
+
+	if len(discord.requests) != 0 {
+		t.Fatalf("expected no requests which modify application commands, got: %v", discord.requests)
+	}
+
+	_, err = syncer.Plan(disgoform.WithGuildDiscovery(disgoform.GuildDiscoveryDeclared), disgoform.WithMaxDeletions(1))
+	if !errors.As(err, &deletionErr) || deletionErr.Deletions != 2 {
+		t.Fatalf("plan: expected a DeletionError of 2 deletions, got: %v", err)
+	}
+}
+
+// TestPermissionsDrift tests the comparison of current and declared permission overwrites.
+func TestPermissionsDrift(t *testing.T) {
+	role := func(id string) *disgo.ApplicationCommandPermissions {
+		return &disgo.ApplicationCommandPermissions{ID: id, Type: disgo.FlagApplicationCommandPermissionTypeROLE, Permission: true}
+	}
+
+	tests := []struct {
+		name     string
+		current  string
+		declared []*disgo.ApplicationCommandPermissions
+		expected disgoform.Action
+	}{
+		{
+			name:     "reordered",
+			current:  `[{"id": "2", "type": 1, "permission": true}, {"id": "3", "type": 1, "permission": true}]`,
+			declared: []*disgo.ApplicationCommandPermissions{role("3"), role("2")},
+			expected: disgoform.ActionNoOp,
+		},
+		{
+			name:     "duplicate",
+			current:  `[{"id": "2", "type": 1, "permission": true}, {"id": "3", "type": 1, "permission": true}]`,
+			declared: []*disgo.ApplicationCommandPermissions{role("2"), role("2")},
+			expected: disgoform.ActionUpdate,
+		},
+		{
+			name:     "nil",
+			current:  `[{"id": "2", "type": 1, "permission": true}]`,
+			declared: []*disgo.ApplicationCommandPermissions{nil},
+			expected: disgoform.ActionUpdate,
+		},
+	}
+
+	for _, test := range tests {
+		bot, _ := newFakeDiscord(t, map[string]string{
+			"/api/v10/applications/0/guilds/1/commands": `[
+				{"id": "1", "application_id": "0", "guild_id": "1", "name": "main", "description": "A command.", "version": "1", "type": 1}
+			]`,
+			"/api/v10/applications/0/guilds/1/commands/permissions": `[
+				{"id": "1", "application_id": "0", "guild_id": "1", "permissions": ` + test.current + `}
+			]`,
+		})
+
+		syncer := disgoform.NewSyncer(disgoform.Config{ //nolint:exhaustruct
+			Client: bot,
+			ApplicationCommandPermissions: []disgoform.CommandPermissions{
+				{GuildID: "1", Name: "main", Type: nil, Permissions: test.declared},
+			},
+		})
+
+		result, err := syncer.SyncApplicationCommandPermissions(disgoform.DryRun())
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		if len(result.Permissions) != 1 || result.Permissions[0].Action != test.expected {
+			t.Errorf("%s: expected action %q, got: %v", test.name, test.expected, result.Permissions)
+		}
+	}
+}
+
+// TestAggregateError tests the errors of an AggregateError.
+func TestAggregateError(t *testing.T) {
+	errRequest := errors.New("request failed")
+
+	var err error = &disgoform.AggregateError{
+		Errors: []*disgoform.OperationError{
+			{
+				Scope:   disgoform.ScopeGuild,
+				GuildID: "1",
+				Command: disgoform.CommandKey{Name: "main", Type: disgo.FlagApplicationCommandTypeCHAT_INPUT},
+				Action:  disgoform.ActionCreate,
+				Err:     errRequest,
+			},
+		},
+	}
+
+	var operationErr *disgoform.OperationError
+	if !errors.As(err, &operationErr) {
+		t.Fatal("expected *OperationError")
+	}
+
+	if operationErr.GuildID != "1" || operationErr.Action != disgoform.ActionCreate {
+		t.Fatalf("unexpected *OperationError: %+v", operationErr)
+	}
+
+	if !errors.Is(err, errRequest) {
+		t.Fatal("expected underlying error")
+	}
+}
+
+// TestZerologHandler tests the structured fields written by a ZerologHandler.
+func TestZerologHandler(t *testing.T) {
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	var buffer bytes.Buffer
+
+	logger := slog.New(disgoform.NewZerologHandler(zerolog.New(&buffer))).With(slog.String("scope", "guild"))
+	logger.WithGroup("request").Info("application command synchronized",
+		slog.String("guild_id", "1"),
+		slog.Duration("duration", time.Second),
+	)
+
+	var fields map[string]any
+	if err := json.Unmarshal(buffer.Bytes(), &fields); err != nil {
+		t.Fatalf("unexpected output %q: %v", buffer.String(), err)
+	}
+
+	expected := map[string]any{
+		"level":            "info",
+		"message":          "application command synchronized",
+		"scope":            "guild",
+		"request.guild_id": "1",
+		"request.duration": float64(1000),
+	}
+
+	for key, value := range expected {
+		if fields[key] != value {
+			t.Errorf("field %q: expected %v, got %v", key, value, fields[key])
+		}
+	}
+}
+
+// testValidate represents parameters used to test application command validation.
+type testValidate struct {
+	name     string
+	config   disgoform.Config
+	expected []string
+}
+
+// TestValidate tests application command validation.
+func TestValidate(t *testing.T) {
+	// options returns options whose choice names are short, but localized at the maximum choice name length.
+	options := func(localized bool) []*disgo.ApplicationCommandOption {
+		options := make([]*disgo.ApplicationCommandOption, 4)
+		for i := range options {
+			options[i] = &disgo.ApplicationCommandOption{
+				Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+				Name:        "option" + strconv.Itoa(i),
+				Description: "An option.",
+				Choices:     make([]*disgo.ApplicationCommandOptionChoice, 25),
+			}
+
+			for j := range options[i].Choices {
+				options[i].Choices[j] = &disgo.ApplicationCommandOptionChoice{Name: "a", Value: "1"}
+				if localized {
+					options[i].Choices[j].NameLocalizations = &map[string]string{disgo.FlagLocalesFrench: strings.Repeat("b", 100)}
+				}
+			}
+		}
+
+		return options
+	}
+
+	// sized returns a command whose name, description, and value properties combine to a size (at least 20 characters).
+	sized := func(size int) disgo.CreateGlobalApplicationCommand {
+		command := disgo.CreateGlobalApplicationCommand{Name: "main", Description: disgo.Pointer("A basic command.")} //nolint:exhaustruct
+
+		for remaining := size - len(command.Name) - len(*command.Description); remaining > 0; {
+			option := &disgo.ApplicationCommandOption{ //nolint:exhaustruct
+				Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+				Name:        "option" + strconv.Itoa(len(command.Options)),
+				Description: "An option.",
+			}
+
+			remaining -= len(option.Name) + len(option.Description)
+
+			for len(option.Choices) < 25 && remaining > 0 {
+				name := strconv.Itoa(len(option.Choices))
+				value := strings.Repeat("v", min(100, remaining-len(name)))
+				option.Choices = append(option.Choices, &disgo.ApplicationCommandOptionChoice{Name: name, Value: disgo.Value(value)}) //nolint:exhaustruct
+				remaining -= len(name) + len(value)
+			}
+
+			command.Options = append(command.Options, option)
+		}
+
+		return command
+	}
+
+	tests := []testValidate{
+		{
+			name: "valid",
+			config: disgoform.Config{ //nolint:exhaustruct
+				GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+					{
+						Name:        "main",
+						Description: disgo.Pointer("A basic command."),
+						Options: []*disgo.ApplicationCommandOption{
+							{
+								Type:        disgo.FlagApplicationCommandOptionTypeINTEGER,
+								Name:        "amount",
+								Description: "An amount.",
+								Required:    disgo.Pointer(true),
+								Choices: []*disgo.ApplicationCommandOptionChoice{
+									{Name: "One", Value: "1"},
+								},
+							},
+						},
+					},
+					{
+						Name: "Report Message",
+						Type: disgo.Pointer(disgo.FlagApplicationCommandTypeMESSAGE),
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "names",
+			config: disgoform.Config{ //nolint:exhaustruct
+				GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+					{Name: "Main", Description: disgo.Pointer("A basic command.")},
+					{Name: "main command", Description: disgo.Pointer("A basic command.")},
+					{Name: "main", Description: disgo.Pointer("")},
+				},
+			},
+			expected: []string{
+				"GlobalApplicationCommands[0].name",
+				"GlobalApplicationCommands[1].name",
+				"GlobalApplicationCommands[2].description",
+			},
+		},
+		{
+			name: "apostrophe",
+			config: disgoform.Config{ //nolint:exhaustruct
+				GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+					{Name: "don't", Description: disgo.Pointer("A basic command.")},
+					{Name: "don\"t", Description: disgo.Pointer("A basic command.")},
+				},
+			},
+			expected: []string{
+				"GlobalApplicationCommands[1].name",
+			},
+		},
+		{
+			name: "combined size",
+			config: disgoform.Config{ //nolint:exhaustruct
+				GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+					{Name: "main", Description: disgo.Pointer("A basic command."), Options: options(false)},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "combined size limit",
+			config: disgoform.Config{ //nolint:exhaustruct
+				GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{sized(8000)},
+			},
+			expected: nil,
+		},
+		{
+			name: "combined size limit exceeded",
+			config: disgoform.Config{ //nolint:exhaustruct
+				GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{sized(8001)},
+			},
+			expected: []string{
+				"GlobalApplicationCommands[0]",
+			},
+		},
+		{
+			name: "combined size localizations",
+			config: disgoform.Config{ //nolint:exhaustruct
+				GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+					{Name: "main", Description: disgo.Pointer("A basic command."), Options: options(true)},
+				},
+			},
+			expected: []string{
+				"GlobalApplicationCommands[0]",
+			},
+		},
+		{
+			name: "options",
+			config: disgoform.Config{ //nolint:exhaustruct
+				GuildApplicationCommands: []disgo.CreateGuildApplicationCommand{
+					{
+						GuildID:     "1",
+						Name:        "main",
+						Description: disgo.Pointer("A basic command."),
+						Options: []*disgo.ApplicationCommandOption{
+							{
+								Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+								Name:        "optional",
+								Description: "An optional option.",
+								MinValue:    disgo.Pointer(1.0),
+							},
+							{
+								Type:         disgo.FlagApplicationCommandOptionTypeINTEGER,
+								Name:         "required",
+								Description:  "A required option.",
+								Required:     disgo.Pointer(true),
+								Autocomplete: disgo.Pointer(true),
+								Choices: []*disgo.ApplicationCommandOptionChoice{
+									{Name: "One", Value: "one"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{
+				"GuildApplicationCommands[0].options[0]",
+				"GuildApplicationCommands[0].options[1].required",
+				"GuildApplicationCommands[0].options[1].choices[0].value",
+				"GuildApplicationCommands[0].options[1].autocomplete",
+			},
+		},
+		{
+			name: "subcommands",
+			config: disgoform.Config{ //nolint:exhaustruct
+				GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+					{
+						Name:        "main",
+						Description: disgo.Pointer("A basic command."),
+						Options: []*disgo.ApplicationCommandOption{
+							{
+								Type:        disgo.FlagApplicationCommandOptionTypeSUB_COMMAND,
+								Name:        "sub",
+								Description: "A subcommand.",
+								Options: []*disgo.ApplicationCommandOption{
+									{
+										Type:        disgo.FlagApplicationCommandOptionTypeSUB_COMMAND_GROUP,
+										Name:        "group",
+										Description: "A nested group.",
+									},
+								},
+							},
+							{
+								Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+								Name:        "text",
+								Description: "A text option.",
+							},
+						},
+					},
+				},
+			},
+			expected: []string{
+				"GlobalApplicationCommands[0].options[0].options[0].type",
+				"GlobalApplicationCommands[0].options",
+			},
+		},
+		{
+			name: "limits",
+			config: disgoform.Config{ //nolint:exhaustruct
+				GlobalApplicationCommands: func() []disgo.CreateGlobalApplicationCommand {
+					commands := make([]disgo.CreateGlobalApplicationCommand, 6)
+					for i := range commands {
+						commands[i] = disgo.CreateGlobalApplicationCommand{ //nolint:exhaustruct
+							Name: "user " + strconv.Itoa(i),
+							Type: disgo.Pointer(disgo.FlagApplicationCommandTypeUSER),
+						}
+					}
+
+					return commands
+				}(),
+			},
+			expected: []string{
+				"GlobalApplicationCommands",
+			},
+		},
+		{
+			name: "localizations",
+			config: disgoform.Config{ //nolint:exhaustruct
+				GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+					{
+						Name: "main",
+						NameLocalizations: &map[string]string{
+							disgo.FlagLocalesGerman: "Haupt",
+							"en_US":                 "main",
+						},
+						Description: disgo.Pointer("A basic command."),
+						DescriptionLocalizations: &map[string]string{
+							disgo.FlagLocalesGerman: "",
+						},
+						Options: []*disgo.ApplicationCommandOption{
+							{
+								Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+								Name:        "text",
+								Description: "A text option.",
+								Choices: []*disgo.ApplicationCommandOptionChoice{
+									{
+										Name:              "Text",
+										NameLocalizations: &map[string]string{disgo.FlagLocalesFrench: "Texte"},
+										Value:             "text",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{
+				"GlobalApplicationCommands[0].name_localizations[\"de\"]",
+				"GlobalApplicationCommands[0].name_localizations[\"en_US\"]",
+				"GlobalApplicationCommands[0].description_localizations[\"de\"]",
+			},
+		},
+		{
+			name: "required locales",
+			config: disgoform.Config{ //nolint:exhaustruct
+				GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+					{
+						Name:                     "main",
+						NameLocalizations:        &map[string]string{disgo.FlagLocalesFrench: "principal"},
+						Description:              disgo.Pointer("A basic command."),
+						DescriptionLocalizations: &map[string]string{disgo.FlagLocalesFrench: "Une commande."},
+						Options: []*disgo.ApplicationCommandOption{
+							{
+								Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+								Name:        "text",
+								Description: "A text option.",
+							},
+						},
+					},
+				},
+				RequiredLocales: []string{disgo.FlagLocalesFrench},
+			},
+			expected: []string{
+				"GlobalApplicationCommands[0].options[0].name_localizations",
+				"GlobalApplicationCommands[0].options[0].description_localizations",
+			},
+		},
+		{
+			name: "permissions",
+			config: disgoform.Config{ //nolint:exhaustruct
+				ApplicationCommandPermissions: []disgoform.CommandPermissions{
+					{
+						Name: "main",
+						Permissions: []*disgo.ApplicationCommandPermissions{
+							{ID: "1", Type: 4, Permission: true},
+						},
+					},
+					{GuildID: "1", Name: "main"},
+					{GuildID: "1", Name: "main", Type: disgo.Pointer(disgo.FlagApplicationCommandTypeCHAT_INPUT)},
+				},
+			},
+			expected: []string{
+				"ApplicationCommandPermissions[0].guild_id",
+				"ApplicationCommandPermissions[0].permissions[0].type",
+				"ApplicationCommandPermissions[2]",
+			},
+		},
+		{
+			name: "permission overwrites",
+			config: disgoform.Config{ //nolint:exhaustruct
+				ApplicationCommandPermissions: []disgoform.CommandPermissions{
+					{
+						GuildID: "1",
+						Name:    "main",
+						Permissions: []*disgo.ApplicationCommandPermissions{
+							{ID: "2", Type: disgo.FlagApplicationCommandPermissionTypeROLE, Permission: true},
+							nil,
+							{ID: "2", Type: disgo.FlagApplicationCommandPermissionTypeROLE, Permission: false},
+						},
+					},
+				},
+			},
+			expected: []string{
+				"ApplicationCommandPermissions[0].permissions[1]",
+				"ApplicationCommandPermissions[0].permissions[2].id",
+			},
+		},
+		{
+			name: "patches",
+			config: disgoform.Config{ //nolint:exhaustruct
+				GuildApplicationCommands: []disgo.CreateGuildApplicationCommand{
+					{GuildID: "1", Name: "main", Description: disgo.Pointer("A basic command.")},
+				},
+				PatchedApplicationCommands: []disgoform.PatchedApplicationCommand{
+					{
+						Command: disgo.CreateGuildApplicationCommand{ //nolint:exhaustruct
+							Name:        "main",
+							Description: disgo.Pointer("A basic command."),
+						},
+						Patches: []disgoform.GuildPatch{
+							{GuildID: "1"},
+							{GuildID: "2", Description: disgo.Pointer("")},
+							{GuildID: "3", Choices: []disgoform.ChoicesPatch{{Path: "amount"}}},
+						},
+					},
+				},
+			},
+			expected: []string{
+				"PatchedApplicationCommands[0].Patches[2]",
+				"PatchedApplicationCommands[0].Patches[0].name",
+				"PatchedApplicationCommands[0].Patches[1].description",
+			},
+		},
+		{
+			name: "templates",
+			config: disgoform.Config{ //nolint:exhaustruct
+				GuildApplicationCommands: []disgo.CreateGuildApplicationCommand{
+					{GuildID: "1", Name: "premium", Description: disgo.Pointer("A premium command.")},
+				},
+				GuildApplicationCommandTemplates: []disgoform.GuildApplicationCommandTemplate{
+					{
+						Command: disgo.CreateGuildApplicationCommand{ //nolint:exhaustruct
+							Name:        "premium",
+							Description: disgo.Pointer("A premium command."),
+						},
+						GuildIDs: []string{"1", ""},
+						Selector: nil,
+					},
+					{
+						Command: disgo.CreateGuildApplicationCommand{ //nolint:exhaustruct
+							Name:        "Premium",
+							Description: disgo.Pointer("A premium command."),
+						},
+						GuildIDs: nil,
+						Selector: nil,
+					},
+				},
+			},
+			expected: []string{
+				"GuildApplicationCommandTemplates[0].name",
+				"GuildApplicationCommandTemplates[0].GuildIDs[1]",
+				"GuildApplicationCommandTemplates[1].name",
+			},
+		},
+		{
+			name: "prevent destroy",
+			config: disgoform.Config{ //nolint:exhaustruct
+				PreventDestroy: []disgoform.ProtectedCommand{
+					{GuildID: "", Name: "main", Type: nil},
+					{GuildID: "1", Name: "", Type: nil},
+				},
+			},
+			expected: []string{
+				"PreventDestroy[1].name",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		err := disgoform.NewSyncer(test.config).Validate()
+
+		var paths []string
+
+		var validationErr *disgoform.ValidationError
+		if errors.As(err, &validationErr) {
+			for _, violation := range validationErr.Violations {
+				paths = append(paths, violation.Path)
+			}
+		} else if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+
+		if !slices.Equal(paths, test.expected) {
+			t.Errorf("%s: expected violations %v, got %v", test.name, test.expected, err)
+		}
+	}
+}
+
+// testLoad represents parameters used to test loading definition files.
+type testLoad struct {
+	name     string
+	data     string
+	expected string
+}
+
+// TestLoad tests loading application command definitions from definition files.
+func TestLoad(t *testing.T) {
+	expected := &disgoform.Definitions{
+		GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+			{
+				Name:              "main",
+				NameLocalizations: &map[string]string{disgo.FlagLocalesFrench: "principal"},
+				Description:       disgo.Pointer("A basic command."),
+				Options: []*disgo.ApplicationCommandOption{
+					{
+						Type:        disgo.FlagApplicationCommandOptionTypeINTEGER,
+						Name:        "amount",
+						Description: "An amount.",
+						Choices: []*disgo.ApplicationCommandOptionChoice{
+							{Name: "One", Value: "1"},
+						},
+					},
+				},
+				Contexts: []disgo.Flag{disgo.FlagInteractionContextTypeGUILD},
+			},
+		},
+		GuildApplicationCommands: []disgo.CreateGuildApplicationCommand{
+			{
+				GuildID:                  "123",
+				Name:                     "Report Message",
+				Type:                     disgo.Pointer(disgo.FlagApplicationCommandTypeMESSAGE),
+				DefaultMemberPermissions: disgo.Pointer2("8"),
+			},
+		},
+	}
+
+	tests := []testLoad{
+		{
+			name: "commands.yaml",
+			data: `global:
+  - name: main
+    name_localizations:
+      fr: principal
+    description: A basic command.
+    contexts: [GUILD]
+    options:
+      - type: INTEGER
+        name: amount
+        description: An amount.
+        choices:
+          - name: One
+            value: 1
+guilds:
+  - guild_id: 123
+    commands:
+      - name: Report Message
+        type: MESSAGE
+        default_member_permissions: 8
+`,
+		},
+		{
+			name: "commands.json",
+			data: `{
+	"$schema": "./disgoform.schema.json",
+	"global": [
+		{
+			"name": "main",
+			"name_localizations": {"fr": "principal"},
+			"description": "A basic command.",
+			"contexts": ["GUILD"],
+			"options": [
+				{
+					"type": "INTEGER",
+					"name": "amount",
+					"description": "An amount.",
+					"choices": [{"name": "One", "value": 1}]
+				}
+			]
+		}
+	],
+	"guilds": [
+		{
+			"guild_id": "123",
+			"commands": [{"name": "Report Message", "type": "MESSAGE", "default_member_permissions": "8"}]
+		}
+	]
+}
+`,
+		},
+		{
+			name: "commands.toml",
+			data: `[[global]]
+name = "main"
+name_localizations = { fr = "principal" }
+description = "A basic command."
+contexts = ["GUILD"]
+
+[[global.options]]
+type = "INTEGER"
+name = "amount"
+description = "An amount."
+choices = [{ name = "One", value = 1 }]
+
+[[guilds]]
+guild_id = "123"
+
+[[guilds.commands]]
+name = "Report Message"
+type = "MESSAGE"
+default_member_permissions = "8"
+`,
+		},
+		{
+			name:     "unknown_field.yaml",
+			data:     "global:\n  - name: main\n    descripton: A basic command.\n",
+			expected: "unknown_field.yaml:3: field descripton not found in type disgoform.globalCommandDefinition",
+		},
+		{
+			name:     "unknown_type.yaml",
+			data:     "global:\n  - name: main\n    type: SLASH\n",
+			expected: `unknown_type.yaml:3: unknown application command type "SLASH"`,
+		},
+		{
+			name:     "guild_field.json",
+			data:     "{\n\t\"guilds\": [\n\t\t{\"guild_id\": \"1\", \"commands\": [{\"name\": \"main\", \"contexts\": [\"GUILD\"]}]}\n\t]\n}\n",
+			expected: "guild_field.json:3: field contexts not found in type disgoform.commandDefinition",
+		},
+		{
+			name:     "unknown_field.toml",
+			data:     "[[global]]\nname = \"main\"\ndescripton = \"A basic command.\"\n",
+			expected: "unknown_field.toml:3: field global.descripton not found",
+		},
+		{
+			name:     "unknown_type.toml",
+			data:     "[[global]]\nname = \"other\"\n\n[[global]]\nname = \"main\"\ntype = \"SLASH\"\n",
+			expected: `unknown_type.toml:6: unknown application command type "SLASH"`,
+		},
+		{
+			name:     "unknown_permission_type.yaml",
+			data:     "guilds:\n  - guild_id: 1\n    permissions:\n      - overwrites:\n          - {type: GROUP, id: 2, permission: true}\n",
+			expected: `unknown_permission_type.yaml:5: unknown application command permission type "GROUP"`,
+		},
+		{
+			name:     "all_channels.yaml",
+			data:     "guilds:\n  - guild_id: server\n    permissions:\n      - overwrites:\n          - type: ROLE\n            id: 2\n            permission: true\n          - type: CHANNEL\n            id: ALL_CHANNELS\n            permission: false\n",
+			expected: `all_channels.yaml:9: guild "server": ALL_CHANNELS: AllChannelsPermissionID: invalid guild ID "server"`,
+		},
+		{
+			name:     "all_channels.toml",
+			data:     "[[guilds]]\nguild_id = \"server\"\n\n[[guilds.permissions]]\noverwrites = [\n  { type = \"ROLE\", id = 2, permission = true },\n  { type = \"CHANNEL\", id = \"ALL_CHANNELS\", permission = false },\n]\n",
+			expected: `all_channels.toml:7: guild "server": ALL_CHANNELS: AllChannelsPermissionID: invalid guild ID "server"`,
+		},
+		{
+			name:     "commands.txt",
+			data:     "",
+			expected: `unsupported definition file extension ".txt"`,
+		},
+	}
+
+	for _, test := range tests {
+		definitions, err := disgoform.Load(test.name, []byte(test.data))
+
+		if test.expected == "" {
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(definitions, expected) {
+				t.Errorf("%s: unexpected definitions", test.name)
+			}
+
+			continue
+		}
+
+		if err == nil || err.Error() != "Load: "+test.expected {
+			t.Errorf("%s: expected error %q, got %v", test.name, test.expected, err)
+		}
+
+		var loadErr *disgoform.LoadError
+		if test.name != "commands.txt" && !errors.As(err, &loadErr) {
+			t.Errorf("%s: expected a *LoadError, got %T", test.name, err)
+		}
+	}
+}
+
+// testValidateFile represents parameters used to test validating definition files.
+type testValidateFile struct {
+	name string
+	data string
+
+	// expected represents the expected violations (path:line).
+	expected []string
+}
+
+// TestValidateFile tests that the violations of a definition file contain their line.
+func TestValidateFile(t *testing.T) {
+	tests := []testValidateFile{
+		{
+			name:     "valid.yaml",
+			data:     "global:\n  - name: main\n    description: A basic command.\n",
+			expected: nil,
+		},
+		{
+			name: "commands.yaml",
+			data: `global:
+  -
+  - name: main
+    description: A basic command.
+    options:
+      - type: SUB_COMMAND
+        name: add
+        description: Add an amount.
+        options:
+          - type: INTEGER
+            name: Amount
+            description: An amount.
+            choices:
+              -
+              - name: One
+                value: one
+guilds:
+  - guild_id: 1
+    permissions:
+      - overwrites:
+          - {type: ROLE, id: 2, permission: true}
+          - {type: ROLE, id: 2, permission: false}
+`,
+			expected: []string{
+				"GlobalApplicationCommands[0].options[0].options[0].name:11",
+				"GlobalApplicationCommands[0].options[0].options[0].choices[0].value:16",
+				"ApplicationCommandPermissions[0].permissions[1].id:22",
+			},
+		},
+		{
+			name: "commands.json",
+			data: `{
+	"global": [
+		{"name": "main", "description": "A basic command."},
+		{"name": "Other", "description": "Another command."}
+	]
+}
+`,
+			expected: []string{"GlobalApplicationCommands[1].name:4"},
+		},
+		{
+			name: "commands.toml",
+			data: `[[global]]
+name = "main"
+description = "A basic command."
+
+[[global]]
+name = "other"
+description = "Another command."
+
+[[global.options]]
+type = "INTEGER"
+name = "amount"
+description = "An amount."
+choices = [
+  { name = "One", value = 1 },
+  { name = "Two", value = "two" },
+]
+
+[[global.options]]
+type = "STRING"
+name = "Text"
+description = "A text."
+`,
+			expected: []string{
+				"GlobalApplicationCommands[1].options[0].choices[1].value:15",
+				"GlobalApplicationCommands[1].options[1].name:20",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		name := filepath.Join(t.TempDir(), test.name)
+		if err := os.WriteFile(name, []byte(test.data), 0o600); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+
+		_, err := disgoform.ValidateFile(name)
+
+		var validationErr *disgoform.ValidationError
+		if err != nil && !errors.As(err, &validationErr) {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+
+		var violations []string
+		if validationErr != nil {
+			for _, violation := range validationErr.Violations {
+				violations = append(violations, violation.Path+":"+strconv.Itoa(violation.Line))
+			}
+		}
+
+		if !slices.Equal(violations, test.expected) {
+			t.Errorf("%s: expected violations %v, got %v", test.name, test.expected, err)
+		}
+	}
+}
+
+// TestDefinitionFile tests outputting application command definitions as definition files.
+func TestDefinitionFile(t *testing.T) {
+	definitions := &disgoform.Definitions{
+		GlobalApplicationCommands: []disgo.CreateGlobalApplicationCommand{
+			{
+				Name:        "main",
+				Description: disgo.Pointer("A basic command."),
+				Options: []*disgo.ApplicationCommandOption{
+					{
+						Type:         disgo.FlagApplicationCommandOptionTypeCHANNEL,
+						Name:         "channel",
+						Description:  "A channel.",
+						ChannelTypes: []disgo.Flag{disgo.FlagChannelTypeGUILD_TEXT},
+					},
+				},
+				Contexts: []disgo.Flag{disgo.FlagInteractionContextTypeGUILD, disgo.FlagInteractionContextTypeBOT_DM},
+			},
+		},
+		GuildApplicationCommands: []disgo.CreateGuildApplicationCommand{
+			{
+				GuildID:                  "123",
+				Name:                     "Report Message",
+				Type:                     disgo.Pointer(disgo.FlagApplicationCommandTypeMESSAGE),
+				DefaultMemberPermissions: disgo.Pointer2("8"),
+			},
+		},
+		ApplicationCommandPermissions: []disgoform.CommandPermissions{
+			{
+				GuildID: "123",
+				Name:    "main",
+				Permissions: []*disgo.ApplicationCommandPermissions{
+					{ID: "123", Type: disgo.FlagApplicationCommandPermissionTypeROLE, Permission: false},
+					{ID: "122", Type: disgo.FlagApplicationCommandPermissionTypeCHANNEL, Permission: false},
+					{ID: "456", Type: disgo.FlagApplicationCommandPermissionTypeUSER, Permission: true},
+				},
+			},
+		},
+		PreventDestroy: []disgoform.ProtectedCommand{
+			{GuildID: "123", Name: "Report Message", Type: disgo.Pointer(disgo.FlagApplicationCommandTypeMESSAGE)},
+		},
+	}
+
+	for _, name := range []string{"commands.yaml", "commands.json", "commands.toml"} {
+		data, err := disgoform.DefinitionFile(name, definitions)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		loaded, err := disgoform.Load(name, data)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		if !reflect.DeepEqual(loaded, definitions) {
+			t.Errorf("%s: loaded definitions do not equal the output definitions:\n%s", name, data)
+		}
+	}
+
+	if _, err := disgoform.DefinitionFile("commands.txt", definitions); err == nil {
+		t.Error("expected error while outputting a definition file with an unsupported extension")
+	}
+}
+
+// TestPermissionIDs tests the IDs of permission overwrites which represent every member or channel of a guild.
+func TestPermissionIDs(t *testing.T) {
+	if id := disgoform.EveryonePermissionID("41771983423143937"); id != "41771983423143937" {
+		t.Errorf("EveryonePermissionID: expected %q, got %q", "41771983423143937", id)
+	}
+
+	id, err := disgoform.AllChannelsPermissionID("41771983423143937")
+	if err != nil || id != "41771983423143936" {
+		t.Errorf("AllChannelsPermissionID: expected %q, got %q (%v)", "41771983423143936", id, err)
+	}
+
+	if _, err := disgoform.AllChannelsPermissionID("guild"); err == nil {
+		t.Error("AllChannelsPermissionID: expected error using an invalid guild ID")
+	}
+
+	definitions, err := disgoform.Load("commands.yaml", []byte(`guilds:
+  - guild_id: "41771983423143937"
+    permissions:
+      - overwrites:
+          - {type: ROLE, id: EVERYONE, permission: false}
+          - {type: CHANNEL, id: ALL_CHANNELS, permission: false}
+`))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	expected := []disgoform.CommandPermissions{
+		{
+			GuildID: "41771983423143937",
+			Permissions: []*disgo.ApplicationCommandPermissions{
+				{ID: "41771983423143937", Type: disgo.FlagApplicationCommandPermissionTypeROLE, Permission: false},
+				{ID: "41771983423143936", Type: disgo.FlagApplicationCommandPermissionTypeCHANNEL, Permission: false},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(definitions.ApplicationCommandPermissions, expected) {
+		t.Errorf("Load: unexpected application command permissions %v", definitions.ApplicationCommandPermissions)
+	}
+}
+
+// TestPatchedApplicationCommand tests expanding a patched application command into guild application commands.
+func TestPatchedApplicationCommand(t *testing.T) {
+	base := disgo.CreateGuildApplicationCommand{ //nolint:exhaustruct
+		Name:        "main",
+		Description: disgo.Pointer("A basic command."),
+		Options: []*disgo.ApplicationCommandOption{
+			{
+				Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+				Name:        "color",
+				Description: "A color.",
+				Choices: []*disgo.ApplicationCommandOptionChoice{
+					{Name: "Red", Value: "red"},
+					{Name: "Blue", Value: "blue"},
+				},
+			},
+			{
+				Type:        disgo.FlagApplicationCommandOptionTypeINTEGER,
+				Name:        "amount",
+				Description: "An amount.",
+			},
+		},
+	}
+
+	patched := disgoform.PatchedApplicationCommand{
+		Command: base,
+		Patches: []disgoform.GuildPatch{
+			{GuildID: "1"},
+			{
+				GuildID:                  "2",
+				Description:              disgo.Pointer("A customer command."),
+				DefaultMemberPermissions: disgo.Pointer2("0"),
+				Options: []disgoform.OptionsPatch{
+					{
+						Remove: []string{"amount"},
+						Add: []*disgo.ApplicationCommandOption{
+							{Type: disgo.FlagApplicationCommandOptionTypeBOOLEAN, Name: "private", Description: "Whether the reply is private."},
+						},
+					},
+				},
+				Choices: []disgoform.ChoicesPatch{
+					{
+						Path:   "color",
+						Remove: []string{"Red"},
+						Add:    []*disgo.ApplicationCommandOptionChoice{{Name: "Green", Value: "green"}},
+					},
+				},
+			},
+		},
+	}
+
+	commands, err := patched.Expand()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(commands) != 2 {
+		t.Fatalf("expected 2 commands, got %d", len(commands))
+	}
+
+	unpatched := base
+	unpatched.GuildID = "1"
+
+	if !reflect.DeepEqual(commands[0], unpatched) {
+		t.Errorf("expected unpatched command %v, got %v", unpatched, commands[0])
+	}
+
+	expected := disgo.CreateGuildApplicationCommand{ //nolint:exhaustruct
+		GuildID:                  "2",
+		Name:                     "main",
+		Description:              disgo.Pointer("A customer command."),
+		DefaultMemberPermissions: disgo.Pointer2("0"),
+		Options: []*disgo.ApplicationCommandOption{
+			{
+				Type:        disgo.FlagApplicationCommandOptionTypeSTRING,
+				Name:        "color",
+				Description: "A color.",
+				Choices: []*disgo.ApplicationCommandOptionChoice{
+					{Name: "Blue", Value: "blue"},
+					{Name: "Green", Value: "green"},
+				},
+			},
+			{Type: disgo.FlagApplicationCommandOptionTypeBOOLEAN, Name: "private", Description: "Whether the reply is private."},
+		},
+	}
+
+	if !reflect.DeepEqual(commands[1], expected) {
+		t.Errorf("expected patched command %v, got %v", expected, commands[1])
+	}
+
+	if len(base.Options) != 2 || len(base.Options[0].Choices) != 2 {
+		t.Errorf("expected the base command to be unmodified, got %v", base)
+	}
+
+	tests := []struct {
+		name    string
+		command disgo.CreateGuildApplicationCommand
+		patch   disgoform.GuildPatch
+		err     bool
+	}{
+		{
+			name:    "undefined option",
+			command: base,
+			patch:   disgoform.GuildPatch{GuildID: "3", Options: []disgoform.OptionsPatch{{Remove: []string{"size"}}}}, //nolint:exhaustruct
+			err:     true,
+		},
+		{
+			name: "nil option",
+			command: disgo.CreateGuildApplicationCommand{ //nolint:exhaustruct
+				Name:    "main",
+				Options: []*disgo.ApplicationCommandOption{nil, base.Options[1]},
+			},
+			patch: disgoform.GuildPatch{ //nolint:exhaustruct
+				GuildID: "3",
+				Options: []disgoform.OptionsPatch{{Remove: []string{"amount"}, Add: []*disgo.ApplicationCommandOption{base.Options[0]}}}, //nolint:exhaustruct
+				Choices: []disgoform.ChoicesPatch{{Path: "color", Remove: []string{"Red"}}},                                              //nolint:exhaustruct
+			},
+			err: false,
+		},
+		{
+			name: "nil choice",
+			command: disgo.CreateGuildApplicationCommand{ //nolint:exhaustruct
+				Name: "main",
+				Options: []*disgo.ApplicationCommandOption{
+					{Type: disgo.FlagApplicationCommandOptionTypeSTRING, Name: "color", Description: "A color.", Choices: []*disgo.ApplicationCommandOptionChoice{nil, {Name: "Red", Value: "red"}}}, //nolint:exhaustruct
+				},
+			},
+			patch: disgoform.GuildPatch{ //nolint:exhaustruct
+				GuildID: "3",
+				Choices: []disgoform.ChoicesPatch{{Path: "color", Remove: []string{"Red"}, Add: []*disgo.ApplicationCommandOptionChoice{{Name: "Blue", Value: "blue"}}}}, //nolint:exhaustruct
+			},
+			err: false,
+		},
+		{
+			name:    "nil option add",
+			command: base,
+			patch:   disgoform.GuildPatch{GuildID: "3", Options: []disgoform.OptionsPatch{{Add: []*disgo.ApplicationCommandOption{nil}}}}, //nolint:exhaustruct
+			err:     true,
+		},
+		{
+			name:    "nil choice add",
+			command: base,
+			patch:   disgoform.GuildPatch{GuildID: "3", Choices: []disgoform.ChoicesPatch{{Path: "color", Add: []*disgo.ApplicationCommandOptionChoice{nil}}}}, //nolint:exhaustruct
+			err:     true,
+		},
+	}
+
+	for _, test := range tests {
+		patched := disgoform.PatchedApplicationCommand{
+			Command: test.command,
+			Patches: []disgoform.GuildPatch{test.patch},
+		}
+
+		if _, err := patched.Expand(); (err != nil) != test.err {
+			t.Errorf("%s: expected error %v, got: %v", test.name, test.err, err)
+		}
+	}
+}
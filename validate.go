@@ -0,0 +1,712 @@
+package disgoform
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/switchupcb/disgo"
+)
+
+// Discord Application Command Limits.
+//
+// https://discord.com/developers/docs/interactions/application-commands#application-command-object
+const (
+	maxNameLength         = 32
+	maxDescriptionLength  = 100
+	maxOptions            = 25
+	maxChoices            = 25
+	maxChoiceNameLength   = 100
+	maxChoiceValueLength  = 100
+	maxOptionLength       = 6000
+	maxChatInputCommands  = 100
+	maxUserCommands       = 5
+	maxMessageCommands    = 5
+	maxEntryPointCommands = 1
+	maxPermissions        = 100
+
+	// maxCommandSize represents the maximum combined length of the name, description, and value properties
+	// of an application command, its options, and their choices.
+	//
+	// Discord documents a maximum of 8000 characters (rather than 4000),
+	// which counts the longest localization of each property (fieldSize).
+	maxCommandSize = 8000
+
+	// maxSafeInteger represents the maximum absolute value of an INTEGER or NUMBER option.
+	maxSafeInteger = 1<<53 - 1
+)
+
+// chatInputNameRegex represents the format of CHAT_INPUT application command and option names.
+//
+// https://discord.com/developers/docs/interactions/application-commands#application-command-object-application-command-naming
+var chatInputNameRegex = regexp.MustCompile(`^[-_'\p{L}\p{N}\p{Devanagari}\p{Thai}]{1,32}$`)
+
+// locales represents the locales supported by Discord.
+//
+// https://discord.com/developers/docs/reference#locales
+var locales = map[string]bool{
+	disgo.FlagLocalesDanish:              true,
+	disgo.FlagLocalesGerman:              true,
+	disgo.FlagLocalesEnglishUK:           true,
+	disgo.FlagLocalesEnglishUS:           true,
+	disgo.FlagLocalesSpanish:             true,
+	disgo.FlagLocalesFrench:              true,
+	disgo.FlagLocalesCroatian:            true,
+	disgo.FlagLocalesIndonesian:          true,
+	disgo.FlagLocalesItalian:             true,
+	disgo.FlagLocalesLithuanian:          true,
+	disgo.FlagLocalesHungarian:           true,
+	disgo.FlagLocalesDutch:               true,
+	disgo.FlagLocalesNorwegian:           true,
+	disgo.FlagLocalesPolish:              true,
+	disgo.FlagLocalesPortugueseBrazilian: true,
+	disgo.FlagLocalesRomanian:            true,
+	disgo.FlagLocalesFinnish:             true,
+	disgo.FlagLocalesSwedish:             true,
+	disgo.FlagLocalesVietnamese:          true,
+	disgo.FlagLocalesTurkish:             true,
+	disgo.FlagLocalesCzech:               true,
+	disgo.FlagLocalesGreek:               true,
+	disgo.FlagLocalesBulgarian:           true,
+	disgo.FlagLocalesRussian:             true,
+	disgo.FlagLocalesUkrainian:           true,
+	disgo.FlagLocalesHindi:               true,
+	disgo.FlagLocalesThai:                true,
+	disgo.FlagLocalesChineseChina:        true,
+	disgo.FlagLocalesJapanese:            true,
+	disgo.FlagLocalesChineseTaiwan:       true,
+	disgo.FlagLocalesKorean:              true,
+}
+
+// Violation represents an application command definition which violates a Discord limit.
+type Violation struct {
+	// Path represents the path of the field (e.g., GlobalApplicationCommands[0].options[1].name).
+	Path string `json:"path"`
+
+	// Message represents the violated limit.
+	Message string `json:"message"`
+
+	// Line represents the line of the field in a definition file (ValidateFile).
+	//
+	// Line is 0 when the position of the field is unknown.
+	Line int `json:"line,omitempty"`
+}
+
+// String returns a human-readable representation of the violation.
+func (v Violation) String() string {
+	return v.Path + ": " + v.Message
+}
+
+// ValidationError represents an error that occurs when application command definitions violate Discord limits.
+type ValidationError struct {
+	Violations []Violation
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	violations := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		violations[i] = violation.String()
+	}
+
+	return fmt.Sprintf("%d application command definition violations: %s", len(e.Violations), strings.Join(violations, "; "))
+}
+
+// Validate validates GlobalApplicationCommands and GuildApplicationCommands against Discord limits
+// without sending any request to Discord.
+//
+// Validate returns a *ValidationError containing every violation, or nil when there are no violations.
+// Use the RequireLocales option to require localizations.
+func Validate(opts ...Option) error {
+	return defaultSyncer(nil).Validate(opts...)
+}
+
+// Validate validates the application commands of the Syncer's configuration against Discord limits
+// without sending any request to Discord.
+//
+// Validate returns a *ValidationError containing every violation, or nil when there are no violations.
+// Use the RequireLocales option to require localizations.
+func (s *Syncer) Validate(opts ...Option) error {
+	c := s.Config
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	v := &validator{
+		violations:      nil,
+		requiredLocales: c.RequiredLocales,
+	}
+
+	for i, locale := range c.RequiredLocales {
+		if !locales[locale] {
+			v.addf(fmt.Sprintf("RequiredLocales[%d]", i), "unsupported locale %q", locale)
+		}
+	}
+
+	globalCounter := make(commandCounter)
+
+	for i, command := range c.GlobalApplicationCommands {
+		path := fmt.Sprintf("GlobalApplicationCommands[%d]", i)
+
+		v.validateCommand(path, command.Name, command.NameLocalizations, command.Type, command.Description, command.DescriptionLocalizations, command.Options)
+		v.validateCommandLocalizations(path, command.Type, command.NameLocalizations, command.DescriptionLocalizations)
+		globalCounter.count(v, path, commandKey(command.Type, command.Name))
+	}
+
+	v.validateCommandCounts("GlobalApplicationCommands", globalCounter)
+
+	guildCounters := make(map[string]commandCounter)
+
+	var guildIDs []string
+
+	var guildCommands []pathCommand
+
+	for i, command := range c.GuildApplicationCommands {
+		guildCommands = append(guildCommands, pathCommand{path: fmt.Sprintf("GuildApplicationCommands[%d]", i), command: command})
+	}
+
+	for i := range c.PatchedApplicationCommands {
+		patched := &c.PatchedApplicationCommands[i]
+
+		for j := range patched.Patches {
+			path := fmt.Sprintf("PatchedApplicationCommands[%d].Patches[%d]", i, j)
+
+			command, err := patched.Patches[j].apply(patched.Command)
+			if err != nil {
+				v.add(path, err.Error())
+
+				continue
+			}
+
+			guildCommands = append(guildCommands, pathCommand{path: path, command: command})
+		}
+	}
+
+	for _, guildCommand := range guildCommands {
+		path, command := guildCommand.path, guildCommand.command
+
+		if command.GuildID == "" {
+			v.add(path+".guild_id", "must not be empty")
+		}
+
+		if _, ok := guildCounters[command.GuildID]; !ok {
+			guildCounters[command.GuildID] = make(commandCounter)
+			guildIDs = append(guildIDs, command.GuildID)
+		}
+
+		v.validateCommand(path, command.Name, command.NameLocalizations, command.Type, command.Description, command.DescriptionLocalizations, command.Options)
+		v.validateCommandLocalizations(path, command.Type, command.NameLocalizations, command.DescriptionLocalizations)
+		guildCounters[command.GuildID].count(v, path, commandKey(command.Type, command.Name))
+	}
+
+	templateCounter := make(commandCounter)
+
+	for i := range c.GuildApplicationCommandTemplates {
+		path := fmt.Sprintf("GuildApplicationCommandTemplates[%d]", i)
+		template := &c.GuildApplicationCommandTemplates[i]
+		command := template.Command
+		key := commandKey(command.Type, command.Name)
+
+		v.validateCommand(path, command.Name, command.NameLocalizations, command.Type, command.Description, command.DescriptionLocalizations, command.Options)
+		v.validateCommandLocalizations(path, command.Type, command.NameLocalizations, command.DescriptionLocalizations)
+
+		// a template without a static guild list can be created in every guild.
+		if len(template.GuildIDs) == 0 {
+			templateCounter.count(v, path, key)
+
+			continue
+		}
+
+		for j, guildID := range template.GuildIDs {
+			if guildID == "" {
+				v.add(fmt.Sprintf("%s.GuildIDs[%d]", path, j), "must not be empty")
+
+				continue
+			}
+
+			if _, ok := guildCounters[guildID]; !ok {
+				guildCounters[guildID] = make(commandCounter)
+				guildIDs = append(guildIDs, guildID)
+			}
+
+			guildCounters[guildID].count(v, path, key)
+		}
+	}
+
+	for _, guildID := range guildIDs {
+		v.validateCommandCounts(fmt.Sprintf("GuildApplicationCommands[guild_id=%q]", guildID), guildCounters[guildID])
+	}
+
+	v.validateCommandCounts("GuildApplicationCommandTemplates", templateCounter)
+
+	v.validatePermissions(c.ApplicationCommandPermissions)
+
+	for i, protected := range c.PreventDestroy {
+		if protected.Name == "" {
+			v.add(fmt.Sprintf("PreventDestroy[%d].name", i), "must not be empty")
+		}
+	}
+
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Violations: v.violations}
+}
+
+// pathCommand represents a guild application command and the path of its definition.
+type pathCommand struct {
+	path    string
+	command disgo.CreateGuildApplicationCommand
+}
+
+// validator represents a collector of violations.
+type validator struct {
+	violations []Violation
+
+	// requiredLocales represents the locales every localization map must provide.
+	requiredLocales []string
+}
+
+// add adds a violation to the validator.
+func (v *validator) add(path, message string) {
+	v.violations = append(v.violations, Violation{Path: path, Message: message, Line: 0})
+}
+
+// addf adds a formatted violation to the validator.
+func (v *validator) addf(path, format string, args ...any) {
+	v.add(path, fmt.Sprintf(format, args...))
+}
+
+// validatePermissions validates the permission overwrites of application commands.
+func (v *validator) validatePermissions(commandPermissions []CommandPermissions) {
+	declared := make(map[string]map[CommandKey]bool)
+
+	for i, permissions := range commandPermissions {
+		path := fmt.Sprintf("ApplicationCommandPermissions[%d]", i)
+
+		if permissions.GuildID == "" {
+			v.add(path+".guild_id", "must not be empty")
+		}
+
+		if declared[permissions.GuildID] == nil {
+			declared[permissions.GuildID] = make(map[CommandKey]bool)
+		}
+
+		if key := permissions.Key(); declared[permissions.GuildID][key] {
+			v.addf(path, "more than one permissions are declared for application command %v in guild %q", key, permissions.GuildID)
+		} else {
+			declared[permissions.GuildID][key] = true
+		}
+
+		if len(permissions.Permissions) > maxPermissions {
+			v.addf(path+".permissions", "must have at most %d permissions (got %d)", maxPermissions, len(permissions.Permissions))
+		}
+
+		ids := make(map[string]bool, len(permissions.Permissions))
+
+		for j, permission := range permissions.Permissions {
+			permissionPath := fmt.Sprintf("%s.permissions[%d]", path, j)
+
+			if permission == nil {
+				v.add(permissionPath, "must not be nil")
+
+				continue
+			}
+
+			if permission.ID == "" {
+				v.add(permissionPath+".id", "must not be empty")
+			} else if ids[permission.ID] {
+				v.addf(permissionPath+".id", "more than one permission overwrite exists with id %q", permission.ID)
+			}
+
+			ids[permission.ID] = true
+
+			switch permission.Type {
+			case disgo.FlagApplicationCommandPermissionTypeROLE,
+				disgo.FlagApplicationCommandPermissionTypeUSER,
+				disgo.FlagApplicationCommandPermissionTypeCHANNEL:
+			default:
+				v.addf(permissionPath+".type", "unknown permission type %d", permission.Type)
+			}
+		}
+	}
+}
+
+// commandCounter represents the amount of application commands of each key in a scope.
+type commandCounter map[CommandKey]int
+
+// count counts an application command key and reports a duplicate definition.
+func (c commandCounter) count(v *validator, path string, key CommandKey) {
+	if c[key]++; c[key] == 2 {
+		v.addf(path+".name", "more than one %s command exists with name %q", commandTypeName(key.Type), key.Name)
+	}
+}
+
+// validateCommandCounts validates the amount of application commands of each type in a scope.
+func (v *validator) validateCommandCounts(path string, counter commandCounter) {
+	counts := make(map[disgo.Flag]int)
+	for key := range counter {
+		counts[key.Type]++
+	}
+
+	limits := []struct {
+		flag disgo.Flag
+		max  int
+	}{
+		{flag: disgo.FlagApplicationCommandTypeCHAT_INPUT, max: maxChatInputCommands},
+		{flag: disgo.FlagApplicationCommandTypeUSER, max: maxUserCommands},
+		{flag: disgo.FlagApplicationCommandTypeMESSAGE, max: maxMessageCommands},
+		{flag: disgo.FlagApplicationCommandTypePRIMARY_ENTRY_POINT, max: maxEntryPointCommands},
+	}
+
+	for _, limit := range limits {
+		if counts[limit.flag] > limit.max {
+			v.addf(path, "cannot define more than %d %s commands (got %d)", limit.max, commandTypeName(limit.flag), counts[limit.flag])
+		}
+	}
+}
+
+// validateCommand validates an application command.
+func (v *validator) validateCommand(
+	path, name string, nameLocalizations *map[string]string,
+	typ *disgo.Flag,
+	description *string, descriptionLocalizations *map[string]string,
+	options []*disgo.ApplicationCommandOption,
+) {
+	flag := *normalizeType(typ)
+
+	switch flag {
+	case disgo.FlagApplicationCommandTypeUSER, disgo.FlagApplicationCommandTypeMESSAGE:
+		v.validateContextMenuName(path+".name", name)
+
+		if description != nil && *description != "" {
+			v.addf(path+".description", "must be empty for %s commands", commandTypeName(flag))
+		}
+
+		if len(options) != 0 {
+			v.addf(path+".options", "must be empty for %s commands", commandTypeName(flag))
+		}
+
+		return
+	}
+
+	v.validateName(path+".name", name)
+	v.validateDescription(path+".description", *normalizeDescription(description))
+	v.validateOptions(path+".options", options, 0)
+
+	size := fieldSize(name, nameLocalizations) +
+		fieldSize(*normalizeDescription(description), descriptionLocalizations) +
+		optionsSize(options)
+	if size > maxCommandSize {
+		v.addf(path, "combined name, description, and value properties must not exceed %d characters (got %d)", maxCommandSize, size)
+	}
+}
+
+// validateCommandLocalizations validates the localizations of an application command.
+func (v *validator) validateCommandLocalizations(path string, typ *disgo.Flag, nameLocalizations, descriptionLocalizations *map[string]string) {
+	switch flag := *normalizeType(typ); flag {
+	case disgo.FlagApplicationCommandTypeUSER, disgo.FlagApplicationCommandTypeMESSAGE:
+		v.validateLocalizations(path+".name_localizations", nameLocalizations, v.validateContextMenuName)
+
+		if descriptionLocalizations != nil && len(*descriptionLocalizations) != 0 {
+			v.addf(path+".description_localizations", "must be empty for %s commands", commandTypeName(flag))
+		}
+
+	default:
+		v.validateLocalizations(path+".name_localizations", nameLocalizations, v.validateName)
+		v.validateLocalizations(path+".description_localizations", descriptionLocalizations, v.validateDescription)
+	}
+}
+
+// validateLocalizations validates the locales and localized values of a localization map.
+func (v *validator) validateLocalizations(path string, localizations *map[string]string, validateValue func(path, value string)) {
+	var m map[string]string
+	if localizations != nil {
+		m = *localizations
+	}
+
+	for _, locale := range slices.Sorted(maps.Keys(m)) {
+		localePath := path + "[" + strconv.Quote(locale) + "]"
+
+		if !locales[locale] {
+			v.addf(localePath, "unsupported locale %q", locale)
+
+			continue
+		}
+
+		validateValue(localePath, m[locale])
+	}
+
+	for _, locale := range v.requiredLocales {
+		if _, ok := m[locale]; !ok {
+			v.addf(path, "missing required locale %q", locale)
+		}
+	}
+}
+
+// validateContextMenuName validates the name of a USER or MESSAGE application command.
+func (v *validator) validateContextMenuName(path, name string) {
+	if length := utf8.RuneCountInString(name); length < 1 || length > maxNameLength {
+		v.addf(path, "must be 1-%d characters (got %d)", maxNameLength, length)
+	}
+}
+
+// validateName validates the name of a CHAT_INPUT application command or option.
+func (v *validator) validateName(path, name string) {
+	if !chatInputNameRegex.MatchString(name) {
+		v.addf(path, "must be 1-%d characters and only contain letters, numbers, '-', '_', or \"'\" (got %q)", maxNameLength, name)
+
+		return
+	}
+
+	if strings.ToLower(name) != name {
+		v.addf(path, "must be lowercase (got %q)", name)
+	}
+}
+
+// validateDescription validates the description of a CHAT_INPUT application command or option.
+func (v *validator) validateDescription(path, description string) {
+	if length := utf8.RuneCountInString(description); length < 1 || length > maxDescriptionLength {
+		v.addf(path, "must be 1-%d characters (got %d)", maxDescriptionLength, length)
+	}
+}
+
+// validateOptions validates the options of an application command, subcommand group, or subcommand.
+//
+// parent represents the type of the option which contains the options (0 for an application command).
+func (v *validator) validateOptions(path string, options []*disgo.ApplicationCommandOption, parent disgo.Flag) {
+	if len(options) > maxOptions {
+		v.addf(path, "cannot contain more than %d options (got %d)", maxOptions, len(options))
+	}
+
+	names := make(map[string]bool, len(options))
+	subcommands := 0
+	optional := false
+
+	for i, option := range options {
+		optionPath := path + "[" + strconv.Itoa(i) + "]"
+
+		if option == nil {
+			v.add(optionPath, "must not be nil")
+
+			continue
+		}
+
+		v.validateName(optionPath+".name", option.Name)
+		v.validateDescription(optionPath+".description", option.Description)
+		v.validateLocalizations(optionPath+".name_localizations", option.NameLocalizations, v.validateName)
+		v.validateLocalizations(optionPath+".description_localizations", option.DescriptionLocalizations, v.validateDescription)
+
+		if names[option.Name] {
+			v.addf(optionPath+".name", "more than one option exists with name %q", option.Name)
+		}
+
+		names[option.Name] = true
+
+		switch option.Type {
+		case disgo.FlagApplicationCommandOptionTypeSUB_COMMAND_GROUP:
+			subcommands++
+
+			if parent != 0 {
+				v.add(optionPath+".type", "SUB_COMMAND_GROUP options must be defined at the top level of a command")
+			}
+
+			v.validateOptions(optionPath+".options", option.Options, option.Type)
+
+		case disgo.FlagApplicationCommandOptionTypeSUB_COMMAND:
+			subcommands++
+
+			if parent != 0 && parent != disgo.FlagApplicationCommandOptionTypeSUB_COMMAND_GROUP {
+				v.add(optionPath+".type", "SUB_COMMAND options must be defined at the top level of a command or in a SUB_COMMAND_GROUP")
+			}
+
+			v.validateOptions(optionPath+".options", option.Options, option.Type)
+
+		default:
+			if parent == disgo.FlagApplicationCommandOptionTypeSUB_COMMAND_GROUP {
+				v.add(optionPath+".type", "SUB_COMMAND_GROUP options must only contain SUB_COMMAND options")
+			}
+
+			if len(option.Options) != 0 {
+				v.add(optionPath+".options", "must be empty for options which are not a SUB_COMMAND or SUB_COMMAND_GROUP")
+			}
+
+			required := option.Required != nil && *option.Required
+			if required && optional {
+				v.add(optionPath+".required", "required options must be listed before optional options")
+			}
+
+			optional = optional || !required
+
+			v.validateOptionValues(optionPath, option)
+		}
+	}
+
+	if subcommands != 0 && subcommands != len(options) {
+		v.add(path, "cannot mix SUB_COMMAND or SUB_COMMAND_GROUP options with other options")
+	}
+}
+
+// validateOptionValues validates the choices and value constraints of an option.
+func (v *validator) validateOptionValues(path string, option *disgo.ApplicationCommandOption) {
+	numeric := option.Type == disgo.FlagApplicationCommandOptionTypeINTEGER || option.Type == disgo.FlagApplicationCommandOptionTypeNUMBER
+	choosable := numeric || option.Type == disgo.FlagApplicationCommandOptionTypeSTRING
+
+	if len(option.Choices) != 0 && !choosable {
+		v.add(path+".choices", "must be empty for options which are not a STRING, INTEGER, or NUMBER")
+	}
+
+	if len(option.Choices) > maxChoices {
+		v.addf(path+".choices", "cannot contain more than %d choices (got %d)", maxChoices, len(option.Choices))
+	}
+
+	for i, choice := range option.Choices {
+		choicePath := path + ".choices[" + strconv.Itoa(i) + "]"
+
+		if choice == nil {
+			v.add(choicePath, "must not be nil")
+
+			continue
+		}
+
+		v.validateChoiceName(choicePath+".name", choice.Name)
+		v.validateLocalizations(choicePath+".name_localizations", choice.NameLocalizations, v.validateChoiceName)
+
+		v.validateChoiceValue(choicePath+".value", option.Type, string(choice.Value))
+	}
+
+	if option.Autocomplete != nil && *option.Autocomplete {
+		if !choosable {
+			v.add(path+".autocomplete", "must be false for options which are not a STRING, INTEGER, or NUMBER")
+		}
+
+		if len(option.Choices) != 0 {
+			v.add(path+".autocomplete", "cannot be true for options with choices")
+		}
+	}
+
+	if len(option.ChannelTypes) != 0 && option.Type != disgo.FlagApplicationCommandOptionTypeCHANNEL {
+		v.add(path+".channel_types", "must be empty for options which are not a CHANNEL")
+	}
+
+	if option.MinValue != nil || option.MaxValue != nil {
+		if !numeric {
+			v.add(path, "min_value and max_value must be empty for options which are not an INTEGER or NUMBER")
+		}
+
+		v.validateRange(path+".min_value", option.MinValue)
+		v.validateRange(path+".max_value", option.MaxValue)
+
+		if option.MinValue != nil && option.MaxValue != nil && *option.MinValue > *option.MaxValue {
+			v.add(path+".min_value", "must not be greater than max_value")
+		}
+	}
+
+	if option.MinLength != nil || option.MaxLength != nil {
+		if option.Type != disgo.FlagApplicationCommandOptionTypeSTRING {
+			v.add(path, "min_length and max_length must be empty for options which are not a STRING")
+		}
+
+		if option.MinLength != nil && (*option.MinLength < 0 || *option.MinLength > maxOptionLength) {
+			v.addf(path+".min_length", "must be 0-%d (got %d)", maxOptionLength, *option.MinLength)
+		}
+
+		if option.MaxLength != nil && (*option.MaxLength < 1 || *option.MaxLength > maxOptionLength) {
+			v.addf(path+".max_length", "must be 1-%d (got %d)", maxOptionLength, *option.MaxLength)
+		}
+
+		if option.MinLength != nil && option.MaxLength != nil && *option.MinLength > *option.MaxLength {
+			v.add(path+".min_length", "must not be greater than max_length")
+		}
+	}
+}
+
+// validateChoiceName validates the name of a choice.
+func (v *validator) validateChoiceName(path, name string) {
+	if length := utf8.RuneCountInString(name); length < 1 || length > maxChoiceNameLength {
+		v.addf(path, "must be 1-%d characters (got %d)", maxChoiceNameLength, length)
+	}
+}
+
+// validateChoiceValue validates the value of a choice against the type of its option.
+func (v *validator) validateChoiceValue(path string, optionType disgo.Flag, value string) {
+	switch optionType {
+	case disgo.FlagApplicationCommandOptionTypeSTRING:
+		if length := utf8.RuneCountInString(value); length < 1 || length > maxChoiceValueLength {
+			v.addf(path, "must be 1-%d characters (got %d)", maxChoiceValueLength, length)
+		}
+
+	case disgo.FlagApplicationCommandOptionTypeINTEGER:
+		integer, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			v.addf(path, "must be an integer (got %q)", value)
+
+			return
+		}
+
+		if integer < -maxSafeInteger || integer > maxSafeInteger {
+			v.addf(path, "must be between -2^53 and 2^53 (got %d)", integer)
+		}
+
+	case disgo.FlagApplicationCommandOptionTypeNUMBER:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			v.addf(path, "must be a number (got %q)", value)
+
+			return
+		}
+
+		v.validateRange(path, &number)
+	}
+}
+
+// validateRange validates the range of an INTEGER or NUMBER value.
+func (v *validator) validateRange(path string, value *float64) {
+	if value != nil && (*value < -maxSafeInteger || *value > maxSafeInteger) {
+		v.addf(path, "must be between -2^53 and 2^53 (got %v)", *value)
+	}
+}
+
+// optionsSize returns the combined length of the name, description, and value properties of options.
+func optionsSize(options []*disgo.ApplicationCommandOption) int {
+	size := 0
+
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+
+		size += fieldSize(option.Name, option.NameLocalizations) + fieldSize(option.Description, option.DescriptionLocalizations)
+
+		for _, choice := range option.Choices {
+			if choice != nil {
+				size += fieldSize(choice.Name, choice.NameLocalizations) + utf8.RuneCountInString(string(choice.Value))
+			}
+		}
+
+		size += optionsSize(option.Options)
+	}
+
+	return size
+}
+
+// fieldSize returns the length of a localized property, which Discord counts as the length of its longest value
+// (including the default value).
+//
+// https://discord.com/developers/docs/interactions/application-commands#localization
+func fieldSize(value string, localizations *map[string]string) int {
+	size := utf8.RuneCountInString(value)
+
+	if localizations != nil {
+		for _, localization := range *localizations {
+			size = max(size, utf8.RuneCountInString(localization))
+		}
+	}
+
+	return size
+}